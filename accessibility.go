@@ -2,9 +2,13 @@ package tuslide
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
 )
 
 // AccessibilityMode defines the accessibility profile.
@@ -17,7 +21,10 @@ const (
 	AccessibilityHighContrast
 	// AccessibilityASCII uses ASCII-only characters.
 	AccessibilityASCII
-	// AccessibilityScreenReader optimizes for screen readers.
+	// AccessibilityScreenReader optimizes for screen readers. It also
+	// reduces motion for animations of the slider's state (see
+	// SliderState.SetMotionPreference) unless the state has a preference
+	// or motion is already reduced globally.
 	AccessibilityScreenReader
 )
 
@@ -26,8 +33,20 @@ type AccessibleSlider struct {
 	slider      *Slider
 	mode        AccessibilityMode
 	description string
-	announcer   func(string) // Callback for announcements
+	announcer   Announcer  // Where announcements go (nil for none)
+	debouncer   *Debouncer // Holds value announcements when debouncing
+	debounce    time.Duration
 	focused     bool
+	repeated    string // Last announcement for input that changed nothing
+
+	locale      Locale
+	unitOne     string // Unit name for a value of one
+	unitOther   string // Unit name for other values
+	compactText bool   // Screen reader view uses CompactText
+
+	dragging   bool
+	indicators *StateIndicators // Replaces the mode's indicators if set
+	stateCues  map[InteractionState]StateCue
 }
 
 // AccessibleOption configures an AccessibleSlider.
@@ -40,12 +59,17 @@ func NewAccessibleSlider(slider *Slider, opts ...AccessibleOption) *AccessibleSl
 		mode:        AccessibilityDefault,
 		description: "",
 		focused:     false,
+		locale:      DefaultLocale(),
 	}
 
 	for _, opt := range opts {
 		opt(a)
 	}
 
+	if a.announcer != nil && a.debounce > 0 {
+		a.debouncer = NewDebouncer(a.announcer).WithDelay(a.debounce)
+	}
+
 	// Apply mode-specific settings
 	a.applyMode()
 
@@ -66,10 +90,58 @@ func WithDescription(desc string) AccessibleOption {
 	}
 }
 
-// WithAnnouncer sets a callback for value change announcements.
+// WithAnnouncer sets a callback for value change announcements. Use
+// WithAnnouncerSink to receive politeness levels.
 func WithAnnouncer(f func(string)) AccessibleOption {
 	return func(a *AccessibleSlider) {
-		a.announcer = f
+		a.announcer = nil
+		if f != nil {
+			a.announcer = AnnouncerFunc(f)
+		}
+	}
+}
+
+// WithAnnouncerSink sets where announcements go, e.g. a WriterAnnouncer
+// or a QueueAnnouncer.
+func WithAnnouncerSink(announcer Announcer) AccessibleOption {
+	return func(a *AccessibleSlider) {
+		a.announcer = announcer
+	}
+}
+
+// WithAnnounceDebounce coalesces value announcements: only the value the
+// slider settles on is announced, once it has been still for delay (see
+// Debouncer). Call FlushAnnouncements, or use AnnouncementTick and
+// HandleAnnouncementTick, to deliver them.
+func WithAnnounceDebounce(delay time.Duration) AccessibleOption {
+	return func(a *AccessibleSlider) {
+		a.debounce = delay
+	}
+}
+
+// WithLocale sets the language and number format of descriptions and
+// announcements, e.g. LocaleFromEnv() or a locale from LookupLocale.
+func WithLocale(locale Locale) AccessibleOption {
+	return func(a *AccessibleSlider) {
+		a.locale = locale
+	}
+}
+
+// WithUnit names the unit of the value in descriptions and announcements,
+// in the singular and plural ("decibel", "decibels"). Which one is used
+// follows the locale's plural rule.
+func WithUnit(one, other string) AccessibleOption {
+	return func(a *AccessibleSlider) {
+		a.unitOne = one
+		a.unitOther = other
+	}
+}
+
+// WithCompactText makes the screen reader view use the compact text form
+// ("Volume: 42/100") instead of the full sentence.
+func WithCompactText(compact bool) AccessibleOption {
+	return func(a *AccessibleSlider) {
+		a.compactText = compact
 	}
 }
 
@@ -83,6 +155,7 @@ func (a *AccessibleSlider) applyMode() {
 	case AccessibilityScreenReader:
 		a.applyScreenReader()
 	}
+	a.slider.invalidateLayout()
 }
 
 // applyHighContrast applies high contrast styling.
@@ -115,6 +188,12 @@ func (a *AccessibleSlider) applyScreenReader() {
 	// Use simple ASCII and ensure value is always shown
 	a.slider.symbols = ASCIISymbols()
 	a.slider.showValue = true
+
+	// Animated values are hard to follow by ear
+	state := a.slider.state
+	if state.MotionPreference() == MotionDefault && GlobalMotionPreference() == MotionFull {
+		state.SetMotionPreference(MotionReduced)
+	}
 }
 
 // Slider returns the underlying slider.
@@ -131,8 +210,12 @@ func (a *AccessibleSlider) State() *SliderState {
 func (a *AccessibleSlider) SetFocused(focused bool) {
 	if a.focused != focused {
 		a.focused = focused
+		a.repeated = ""
+		if !focused && a.debouncer != nil {
+			a.debouncer.Drain()
+		}
 		if focused && a.announcer != nil {
-			a.announcer(a.GetFocusAnnouncement())
+			a.announcer.Announce(a.GetFocusAnnouncement(), Polite)
 		}
 	}
 }
@@ -142,52 +225,182 @@ func (a *AccessibleSlider) IsFocused() bool {
 	return a.focused
 }
 
-// Increment increases the value and announces the change.
+// Increment increases the value and announces the change. Disabled and
+// read-only sliders keep their value and announce their state instead,
+// as do Decrement and SetValue.
 func (a *AccessibleSlider) Increment() {
+	if a.blocked() {
+		return
+	}
 	oldValue := a.slider.state.Value()
 	a.slider.state.Increment()
-	newValue := a.slider.state.Value()
-
-	if oldValue != newValue && a.announcer != nil {
-		a.announcer(a.GetValueAnnouncement())
-	}
+	a.announceChange(oldValue)
 }
 
 // Decrement decreases the value and announces the change.
 func (a *AccessibleSlider) Decrement() {
+	if a.blocked() {
+		return
+	}
 	oldValue := a.slider.state.Value()
 	a.slider.state.Decrement()
-	newValue := a.slider.state.Value()
-
-	if oldValue != newValue && a.announcer != nil {
-		a.announcer(a.GetValueAnnouncement())
-	}
+	a.announceChange(oldValue)
 }
 
 // SetValue sets the value and announces the change.
 func (a *AccessibleSlider) SetValue(value float64) {
+	if a.blocked() {
+		return
+	}
 	oldValue := a.slider.state.Value()
 	a.slider.state.SetValue(value)
-	newValue := a.slider.state.Value()
+	a.announceChange(oldValue)
+}
 
-	if oldValue != newValue && a.announcer != nil {
-		a.announcer(a.GetValueAnnouncement())
+// HandleKey adjusts the slider for the keys of DefaultKeyboardHints: → or
+// l increments, ← or h decrements, Home or 0 goes to the minimum and End
+// or $ to the maximum. ↑/k and ↓/j work like → and ←, for vertical
+// sliders. Returns true if msg was one of these keys; disabled sliders
+// ignore keys and return false.
+func (a *AccessibleSlider) HandleKey(msg tea.KeyMsg) bool {
+	if a.slider.disabled {
+		return false
 	}
+
+	state := a.slider.state
+	switch msg.String() {
+	case "right", "l", "up", "k":
+		a.Increment()
+	case "left", "h", "down", "j":
+		a.Decrement()
+	case "home", "0":
+		a.SetValue(state.Min())
+	case "end", "$":
+		a.SetValue(state.Max())
+	default:
+		return false
+	}
+	return true
 }
 
-// View renders the slider with accessibility enhancements.
-func (a *AccessibleSlider) View() string {
-	view := a.slider.View()
+// blocked reports whether the slider ignores changes, announcing its
+// state if so.
+func (a *AccessibleSlider) blocked() bool {
+	if a.slider.Editable() {
+		return false
+	}
+	a.announceOnce(a.withState(a.GetValueAnnouncement()))
+	return true
+}
 
-	// Add focus indicator if focused
-	if a.focused && a.mode != AccessibilityScreenReader {
-		focusIndicator := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("11")).
-			Render("▸ ")
-		view = focusIndicator + view
+// withState adds the slider's disabled or read-only state to text.
+func (a *AccessibleSlider) withState(text string) string {
+	switch {
+	case a.slider.disabled:
+		return a.locale.Format(a.locale.Messages.Disabled, "text", text)
+	case a.slider.readOnly:
+		return a.locale.Format(a.locale.Messages.ReadOnly, "text", text)
+	}
+	return text
+}
+
+// announceChange announces the value if it moved from oldValue. Reaching
+// a boundary is announced assertively along with the value; pushing
+// against one that was already reached is announced politely, once.
+func (a *AccessibleSlider) announceChange(oldValue float64) {
+	boundary := a.GetBoundaryAnnouncement()
+	if a.slider.state.Value() == oldValue {
+		if boundary != "" {
+			a.announceOnce(boundary)
+		}
+		return
+	}
+
+	a.repeated = ""
+	switch {
+	case boundary != "":
+		a.announce(a.format(a.locale.Messages.Boundary,
+			"announcement", a.GetValueAnnouncement(), "boundary", boundary), Assertive)
+	default:
+		a.announce(a.GetValueAnnouncement(), Polite)
+	}
+}
+
+// announceOnce politely announces msg for input that changed nothing,
+// unless it was the last such announcement: a key held against a bound or
+// on a read-only slider would otherwise repeat it on every key repeat.
+// Changing the value or focus allows it again.
+func (a *AccessibleSlider) announceOnce(msg string) {
+	if msg == a.repeated {
+		return
+	}
+	a.repeated = msg
+	a.announce(msg, Polite)
+}
+
+// announce sends a value announcement through the debouncer, if any.
+func (a *AccessibleSlider) announce(msg string, politeness Politeness) {
+	switch {
+	case a.debouncer != nil:
+		a.debouncer.Announce(msg, politeness)
+	case a.announcer != nil:
+		a.announcer.Announce(msg, politeness)
+	}
+}
+
+// FlushAnnouncements delivers a debounced announcement whose delay has
+// run out. Returns true if it delivered.
+func (a *AccessibleSlider) FlushAnnouncements() bool {
+	if a.debouncer == nil {
+		return false
+	}
+	return a.debouncer.Flush()
+}
+
+// AnnouncementTick returns a command that flushes debounced announcements
+// when they are due, or nil if there is nothing to flush. Return it from
+// Update after changing the value.
+func (a *AccessibleSlider) AnnouncementTick() tea.Cmd {
+	if a.debouncer == nil {
+		return nil
 	}
+	return a.debouncer.Tick()
+}
 
-	return view
+// HandleAnnouncementTick flushes if msg is an AnnounceFlushMsg for this
+// slider and returns the command for the next flush, if needed.
+func (a *AccessibleSlider) HandleAnnouncementTick(msg tea.Msg) tea.Cmd {
+	if a.debouncer == nil {
+		return nil
+	}
+	return a.debouncer.HandleTick(msg)
+}
+
+// View renders the slider with accessibility enhancements: states such as
+// focus are shown with the cues of StateIndicators. In screen reader mode
+// the track is replaced by a single line of text (see Text), followed by
+// whether the slider is focused and its description, if one is set.
+func (a *AccessibleSlider) View() string {
+	if a.mode == AccessibilityScreenReader {
+		return a.screenReaderText()
+	}
+	return a.renderWithCues()
+}
+
+// screenReaderText returns the slider's text with its focus and the
+// description set with WithDescription.
+func (a *AccessibleSlider) screenReaderText() string {
+	text := a.Text()
+	if a.compactText {
+		text = a.CompactText()
+	}
+	if a.focused {
+		text = a.locale.Format(a.locale.Messages.Focused, "text", text)
+	}
+	if a.description != "" {
+		text = a.locale.Format(a.locale.Messages.Described, "text", text, "description", a.description)
+	}
+	return text
 }
 
 // GetDescription returns a screen-reader friendly description.
@@ -196,48 +409,123 @@ func (a *AccessibleSlider) GetDescription() string {
 		return a.description
 	}
 
-	state := a.slider.state
-	label := a.slider.label
-	if label == "" {
-		label = "Slider"
+	return a.format(a.locale.Messages.Description)
+}
+
+// Text returns the slider as a single line of text for screen readers,
+// e.g. "Volume slider, 42 of 100, 42 percent, step 1". Disabled and
+// read-only sliders say so at the end.
+func (a *AccessibleSlider) Text() string {
+	if a.slider.indeterminate {
+		return a.withState(a.format(a.locale.Messages.Busy))
 	}
+	return a.withState(a.format(a.locale.Messages.Text))
+}
 
-	return fmt.Sprintf("%s: %.0f of %.0f (%.0f%%)",
-		label,
-		state.Value(),
-		state.Max(),
-		state.Percentage()*100,
-	)
+// CompactText returns the slider as short text, e.g. "Volume: 42/100".
+func (a *AccessibleSlider) CompactText() string {
+	if a.slider.indeterminate {
+		return a.withState(a.format(a.locale.Messages.Busy))
+	}
+	return a.withState(a.format(a.locale.Messages.CompactText))
 }
 
 // GetValueAnnouncement returns an announcement for the current value.
 func (a *AccessibleSlider) GetValueAnnouncement() string {
-	state := a.slider.state
-	return fmt.Sprintf("%.0f, %.0f percent", state.Value(), state.Percentage()*100)
+	return a.format(a.locale.Messages.Value)
 }
 
-// GetFocusAnnouncement returns an announcement when focused.
+// GetFocusAnnouncement returns an announcement when focused. Disabled and
+// read-only sliders announce their state instead of how to adjust them.
 func (a *AccessibleSlider) GetFocusAnnouncement() string {
-	return a.GetDescription() + ". Use arrow keys to adjust."
+	if !a.slider.Editable() {
+		return a.withState(a.GetDescription())
+	}
+	return a.format(a.locale.Messages.Focus, "description", a.GetDescription())
 }
 
 // GetBoundaryAnnouncement returns an announcement for boundary conditions.
 func (a *AccessibleSlider) GetBoundaryAnnouncement() string {
 	state := a.slider.state
 	if state.Value() <= state.Min() {
-		return "Minimum value reached"
+		return a.locale.Messages.Minimum
 	}
 	if state.Value() >= state.Max() {
-		return "Maximum value reached"
+		return a.locale.Messages.Maximum
 	}
 	return ""
 }
 
+// format fills a message template with the slider's value, bounds and
+// percentage, plus any extra name/value pairs.
+func (a *AccessibleSlider) format(template string, pairs ...string) string {
+	state := a.slider.state
+	value := a.formatNumber(state.Value())
+	if a.unitOne != "" || a.unitOther != "" {
+		value = a.locale.WithUnit(value, state.Value(), a.unitOne, a.unitOther)
+	}
+
+	label, name := a.slider.label, a.locale.Messages.Label
+	if label == "" {
+		label = name
+	} else {
+		name = a.locale.Format(a.locale.Messages.Named, "label", label)
+	}
+
+	return a.locale.Format(template, append([]string{
+		"label", label,
+		"name", name,
+		"value", value,
+		"min", a.formatNumber(state.Min()),
+		"max", a.formatNumber(state.Max()),
+		"step", a.locale.localizeDecimals(strconv.FormatFloat(state.Step(), 'f', -1, 64)),
+		"percent", a.locale.Percent(state.Percentage()),
+		"spoken_percent", a.locale.SpokenPercent(state.Percentage()),
+	}, pairs...)...)
+}
+
+// formatNumber formats v as the slider displays it, with the locale's
+// decimal separator. Digits aren't grouped, as the display doesn't group
+// them.
+func (a *AccessibleSlider) formatNumber(v float64) string {
+	return a.locale.localizeDecimals(a.slider.formatNumber(v))
+}
+
+// TextView renders the group as a list for screen readers, one slider per
+// line, marking the focused one. Options such as WithLocale, WithUnit,
+// WithDescription and WithCompactText apply to every slider as they would
+// with NewAccessibleSlider; modes are ignored, as rendering text must not
+// restyle the sliders.
+func (g *SliderGroup) TextView(opts ...AccessibleOption) string {
+	opts = append(opts[:len(opts):len(opts)], WithAccessibilityMode(AccessibilityDefault))
+
+	lines := make([]string, len(g.sliders))
+	for i, slider := range g.sliders {
+		a := NewAccessibleSlider(slider, opts...)
+		a.focused = i == g.focused // Set directly, as SetFocused announces
+		lines[i] = a.locale.Format(a.locale.Messages.ListItem,
+			"index", strconv.Itoa(i+1), "text", a.screenReaderText())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// IndicatorPosition is where a FocusIndicator draws its marker.
+type IndicatorPosition string
+
+const (
+	// IndicatorLeft draws the marker before the content.
+	IndicatorLeft IndicatorPosition = "left"
+	// IndicatorRight draws the marker after the content.
+	IndicatorRight IndicatorPosition = "right"
+	// IndicatorBoth draws the marker on both sides.
+	IndicatorBoth IndicatorPosition = "both"
+)
+
 // FocusIndicator provides a styled focus indicator.
 type FocusIndicator struct {
 	style    lipgloss.Style
 	char     string
-	position string // "left", "right", "both"
+	position IndicatorPosition
 }
 
 // NewFocusIndicator creates a new focus indicator.
@@ -247,7 +535,7 @@ func NewFocusIndicator() *FocusIndicator {
 			Foreground(lipgloss.Color("11")).
 			Bold(true),
 		char:     "▸",
-		position: "left",
+		position: IndicatorLeft,
 	}
 }
 
@@ -263,8 +551,9 @@ func (f *FocusIndicator) WithChar(char string) *FocusIndicator {
 	return f
 }
 
-// WithPosition sets the indicator position ("left", "right", "both").
-func (f *FocusIndicator) WithPosition(pos string) *FocusIndicator {
+// WithPosition sets the indicator position. Unknown positions draw on the
+// left.
+func (f *FocusIndicator) WithPosition(pos IndicatorPosition) *FocusIndicator {
 	f.position = pos
 	return f
 }
@@ -286,11 +575,11 @@ func (f *FocusIndicator) Wrap(content string, focused bool) string {
 	indicator := f.style.Render(f.char)
 
 	switch f.position {
-	case "right":
+	case IndicatorRight:
 		return content + " " + indicator
-	case "both":
+	case IndicatorBoth:
 		return indicator + " " + content + " " + indicator
-	default: // "left"
+	default: // IndicatorLeft
 		return indicator + " " + content
 	}
 }
@@ -345,6 +634,35 @@ func LightHighContrastPalette() HighContrastPalette {
 	}
 }
 
+// ColorBlindSafePalette returns a dark palette built from the Okabe-Ito
+// colors, which stay distinct under protanopia, deuteranopia and
+// tritanopia.
+func ColorBlindSafePalette() HighContrastPalette {
+	return HighContrastPalette{
+		Foreground: lipgloss.Color("#ffffff"), // White
+		Background: lipgloss.Color("#000000"), // Black
+		Accent:     lipgloss.Color("#e69f00"), // Orange
+		Muted:      lipgloss.Color("#666666"), // Gray
+		Warning:    lipgloss.Color("#f0e442"), // Yellow
+		Error:      lipgloss.Color("#d55e00"), // Vermillion
+		Success:    lipgloss.Color("#56b4e9"), // Sky blue
+	}
+}
+
+// ColorBlindSafeLightPalette returns a light palette built from the
+// Okabe-Ito colors.
+func ColorBlindSafeLightPalette() HighContrastPalette {
+	return HighContrastPalette{
+		Foreground: lipgloss.Color("#000000"), // Black
+		Background: lipgloss.Color("#ffffff"), // White
+		Accent:     lipgloss.Color("#0072b2"), // Blue
+		Muted:      lipgloss.Color("#8c8c8c"), // Gray
+		Warning:    lipgloss.Color("#e69f00"), // Orange
+		Error:      lipgloss.Color("#d55e00"), // Vermillion
+		Success:    lipgloss.Color("#009e73"), // Bluish green
+	}
+}
+
 // ApplyPalette applies a high contrast palette to a slider.
 func ApplyPalette(slider *Slider, palette HighContrastPalette) {
 	slider.filledStyle = lipgloss.NewStyle().Foreground(palette.Foreground)
@@ -352,6 +670,7 @@ func ApplyPalette(slider *Slider, palette HighContrastPalette) {
 	slider.handleStyle = lipgloss.NewStyle().Foreground(palette.Accent).Bold(true)
 	slider.labelStyle = lipgloss.NewStyle().Foreground(palette.Foreground).Bold(true)
 	slider.valueStyle = lipgloss.NewStyle().Foreground(palette.Accent)
+	slider.invalidateLayout()
 }
 
 // KeyboardHints provides keyboard shortcut hints.
@@ -392,6 +711,7 @@ type ProgressAnnouncer struct {
 	lastAnnounced int // Last announced percentage (to avoid spam)
 	interval      int // Announce every N percent
 	announcer     func(string)
+	locale        Locale
 }
 
 // NewProgressAnnouncer creates a new progress announcer.
@@ -403,9 +723,16 @@ func NewProgressAnnouncer(interval int, announcer func(string)) *ProgressAnnounc
 		lastAnnounced: -1,
 		interval:      interval,
 		announcer:     announcer,
+		locale:        DefaultLocale(),
 	}
 }
 
+// WithLocale sets the language of the announcements.
+func (p *ProgressAnnouncer) WithLocale(locale Locale) *ProgressAnnouncer {
+	p.locale = locale
+	return p
+}
+
 // Update checks if a new announcement should be made.
 func (p *ProgressAnnouncer) Update(state *SliderState) {
 	if p.announcer == nil {
@@ -418,14 +745,15 @@ func (p *ProgressAnnouncer) Update(state *SliderState) {
 	// Check for completion first (special case)
 	if pct >= 100 && p.lastAnnounced < 100 {
 		p.lastAnnounced = 100
-		p.announcer("Complete")
+		p.announcer(p.locale.Messages.Complete)
 		return
 	}
 
 	// Regular interval announcements
 	if threshold != p.lastAnnounced && threshold > 0 && threshold < 100 {
 		p.lastAnnounced = threshold
-		p.announcer(fmt.Sprintf("%d percent complete", threshold))
+		p.announcer(p.locale.Format(p.locale.Messages.Progress,
+			"spoken_percent", p.locale.SpokenPercent(float64(threshold)/100)))
 	}
 }
 
@@ -434,62 +762,235 @@ func (p *ProgressAnnouncer) Reset() {
 	p.lastAnnounced = -1
 }
 
-// ContrastChecker helps verify color contrast ratios.
+// ContrastLevel is the WCAG 2 conformance level a contrast ratio reaches.
+type ContrastLevel int
+
+const (
+	// ContrastFail is below 3:1.
+	ContrastFail ContrastLevel = iota
+	// ContrastAALarge is at least 3:1: AA for large text and for non-text
+	// elements such as a slider's track and handle.
+	ContrastAALarge
+	// ContrastAA is at least 4.5:1: AA for normal text, AAA for large text.
+	ContrastAA
+	// ContrastAAA is at least 7:1: AAA for normal text.
+	ContrastAAA
+)
+
+// WCAG contrast ratio thresholds.
+const (
+	ContrastRatioAALarge = 3.0
+	ContrastRatioAA      = 4.5
+	ContrastRatioAAA     = 7.0
+)
+
+// String returns the level's name.
+func (l ContrastLevel) String() string {
+	switch l {
+	case ContrastAALarge:
+		return "AA Large"
+	case ContrastAA:
+		return "AA"
+	case ContrastAAA:
+		return "AAA"
+	}
+	return "Fail"
+}
+
+// contrastLevel returns the level a ratio reaches.
+func contrastLevel(ratio float64) ContrastLevel {
+	switch {
+	case ratio >= ContrastRatioAAA:
+		return ContrastAAA
+	case ratio >= ContrastRatioAA:
+		return ContrastAA
+	case ratio >= ContrastRatioAALarge:
+		return ContrastAALarge
+	}
+	return ContrastFail
+}
+
+// ContrastResult is the outcome of a contrast check.
+type ContrastResult struct {
+	Ratio float64       // From 1 (no contrast) to 21 (black on white)
+	Level ContrastLevel // Highest WCAG level reached
+	Pass  bool          // Ratio meets the checker's minimum
+}
+
+// String formats the result like "4.54:1 (AA)".
+func (r ContrastResult) String() string {
+	return fmt.Sprintf("%.2f:1 (%s)", r.Ratio, r.Level)
+}
+
+// ContrastChecker computes WCAG contrast ratios between terminal colors.
+// ANSI colors 0-15 are resolved through a configurable terminal palette,
+// other ANSI256 colors and hex colors directly, and adaptive colors
+// against the terminal background.
 type ContrastChecker struct {
-	minRatio float64
+	minRatio       float64
+	palette        TerminalPalette
+	darkBackground *bool // Detected when first needed
 }
 
 // NewContrastChecker creates a contrast checker with minimum ratio.
 // WCAG AA requires 4.5:1 for normal text, 3:1 for large text.
+// The checker uses DefaultTerminalPalette and the detected background,
+// which is only queried once an adaptive color is checked.
 func NewContrastChecker(minRatio float64) *ContrastChecker {
-	return &ContrastChecker{minRatio: minRatio}
+	return &ContrastChecker{
+		minRatio: minRatio,
+		palette:  DefaultTerminalPalette,
+	}
+}
+
+// WithPalette sets the terminal palette used for ANSI colors 0-15.
+func (c *ContrastChecker) WithPalette(palette TerminalPalette) *ContrastChecker {
+	c.palette = palette
+	return c
+}
+
+// WithDarkBackground sets which side of adaptive colors is checked.
+func (c *ContrastChecker) WithDarkBackground(dark bool) *ContrastChecker {
+	c.darkBackground = &dark
+	return c
+}
+
+// rgb converts a color to RGB, detecting the background the first time an
+// adaptive color needs it.
+func (c *ContrastChecker) rgb(color lipgloss.TerminalColor) (colorful.Color, bool) {
+	if c.darkBackground == nil && isAdaptive(color) {
+		dark := lipgloss.HasDarkBackground()
+		c.darkBackground = &dark
+	}
+	return c.palette.rgb(color, c.darkBackground == nil || *c.darkBackground)
+}
+
+// MinRatio returns the minimum ratio colors must reach to pass.
+func (c *ContrastChecker) MinRatio() float64 {
+	return c.minRatio
+}
+
+// Contrast computes the contrast between fg and bg.
+// It fails if either color has no RGB value, such as NoColor.
+func (c *ContrastChecker) Contrast(fg, bg lipgloss.TerminalColor) (ContrastResult, error) {
+	a, ok := c.rgb(fg)
+	if !ok {
+		return ContrastResult{}, fmt.Errorf("tuslide: can't resolve foreground color %v", fg)
+	}
+	b, ok := c.rgb(bg)
+	if !ok {
+		return ContrastResult{}, fmt.Errorf("tuslide: can't resolve background color %v", bg)
+	}
+
+	ratio := contrast(a, b)
+	return ContrastResult{
+		Ratio: ratio,
+		Level: contrastLevel(ratio),
+		Pass:  ratio >= c.minRatio,
+	}, nil
 }
 
 // CheckColors verifies if two colors have sufficient contrast.
-// Returns a suggestion if contrast is insufficient.
+// Colors are hex ("#ff8800"), ANSI numbers ("196") or basic CSS color
+// names ("navy"). Returns a suggestion if contrast is insufficient.
 func (c *ContrastChecker) CheckColors(fg, bg string) (bool, string) {
-	// Simplified check - in production you'd calculate actual luminance
-	// This is a basic check for obviously low-contrast combinations
-	if fg == bg {
-		return false, "Foreground and background colors are identical"
-	}
-
-	// Check for common low-contrast combinations
-	lowContrast := map[string][]string{
-		"gray":    {"gray", "darkgray", "lightgray"},
-		"blue":    {"darkblue", "navy"},
-		"green":   {"darkgreen"},
-		"red":     {"darkred", "maroon"},
-		"yellow":  {"lightyellow", "white"},
-		"white":   {"lightyellow", "lightgray"},
-		"black":   {"darkgray", "navy", "darkgreen", "darkred"},
-	}
-
-	fgLower := strings.ToLower(fg)
-	bgLower := strings.ToLower(bg)
-
-	if similar, ok := lowContrast[fgLower]; ok {
-		for _, s := range similar {
-			if bgLower == s {
-				return false, fmt.Sprintf("Low contrast between %s and %s", fg, bg)
-			}
-		}
+	result, err := c.Contrast(namedColor(fg), namedColor(bg))
+	if err != nil {
+		return false, fmt.Sprintf("Unknown color in %q on %q", fg, bg)
+	}
+	if !result.Pass {
+		return false, fmt.Sprintf("Low contrast between %s and %s: %.2f:1, needs %.1f:1; try %s",
+			fg, bg, result.Ratio, c.minRatio, c.SuggestHighContrast(bg))
 	}
-
 	return true, ""
 }
 
-// SuggestHighContrast suggests a high contrast alternative.
+// SuggestHighContrast suggests a foreground for bg, given like CheckColors'
+// colors: "white" or "black", whichever contrasts more.
 func (c *ContrastChecker) SuggestHighContrast(bg string) string {
-	bgLower := strings.ToLower(bg)
-
-	// Suggest contrasting foreground based on background
-	switch {
-	case strings.Contains(bgLower, "dark") || bgLower == "black" || bgLower == "navy":
-		return "white"
-	case strings.Contains(bgLower, "light") || bgLower == "white" || bgLower == "yellow":
+	if c.SuggestHighContrastColor(namedColor(bg)) == lipgloss.Color("#000000") {
 		return "black"
-	default:
-		return "white" // Default to white for safety
 	}
+	return "white"
+}
+
+// SuggestHighContrastColor suggests a foreground for bg: white or black,
+// whichever contrasts more. Either reaches 4.5:1 on any background.
+func (c *ContrastChecker) SuggestHighContrastColor(bg lipgloss.TerminalColor) lipgloss.Color {
+	white, black := lipgloss.Color("#ffffff"), lipgloss.Color("#000000")
+	onWhite, err := c.Contrast(white, bg)
+	if err != nil {
+		return white
+	}
+	onBlack, _ := c.Contrast(black, bg)
+	if onBlack.Ratio > onWhite.Ratio {
+		return black
+	}
+	return white
+}
+
+// EnsureContrast returns fg if it contrasts enough with bg, otherwise the
+// closest color of the same hue that does: fg is lightened or darkened
+// just enough to reach the minimum ratio, or as far as possible if it
+// can't be reached. Colors without RGB values are returned unchanged.
+func (c *ContrastChecker) EnsureContrast(fg, bg lipgloss.TerminalColor) lipgloss.TerminalColor {
+	a, okA := c.rgb(fg)
+	b, okB := c.rgb(bg)
+	if !okA || !okB || contrast(a, b) >= c.minRatio {
+		return fg
+	}
+
+	// Move toward whichever extreme contrasts more with the background
+	target, _ := colorful.Hex("#ffffff")
+	if black, _ := colorful.Hex("#000000"); contrast(black, b) > contrast(target, b) {
+		target = black
+	}
+	if contrast(target, b) < c.minRatio {
+		return lipgloss.Color(target.Hex())
+	}
+
+	// Contrast grows along the blend, so bisect for the smallest step
+	lo, hi := 0.0, 1.0
+	for range 30 {
+		mid := (lo + hi) / 2
+		if contrast(a.BlendLab(target, mid).Clamped(), b) >= c.minRatio {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return lipgloss.Color(a.BlendLab(target, hi).Clamped().Hex())
+}
+
+// cssColors maps basic CSS color names to hex colors for CheckColors.
+var cssColors = map[string]string{
+	"black":       "#000000",
+	"white":       "#ffffff",
+	"gray":        "#808080",
+	"grey":        "#808080",
+	"darkgray":    "#a9a9a9",
+	"lightgray":   "#d3d3d3",
+	"red":         "#ff0000",
+	"darkred":     "#8b0000",
+	"maroon":      "#800000",
+	"green":       "#008000",
+	"darkgreen":   "#006400",
+	"blue":        "#0000ff",
+	"darkblue":    "#00008b",
+	"navy":        "#000080",
+	"yellow":      "#ffff00",
+	"lightyellow": "#ffffe0",
+	"orange":      "#ffa500",
+	"purple":      "#800080",
+	"cyan":        "#00ffff",
+	"magenta":     "#ff00ff",
+}
+
+// namedColor converts a CSS color name to a lipgloss color, passing other
+// strings through.
+func namedColor(s string) lipgloss.Color {
+	if hex, ok := cssColors[strings.ToLower(strings.TrimSpace(s))]; ok {
+		return lipgloss.Color(hex)
+	}
+	return lipgloss.Color(s)
 }
@@ -1,8 +1,13 @@
 package tuslide
 
 import (
+	"math"
 	"strings"
 	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestNewAccessibleSlider(t *testing.T) {
@@ -49,6 +54,8 @@ func TestAccessibleSlider_ASCIIMode(t *testing.T) {
 }
 
 func TestAccessibleSlider_ScreenReaderMode(t *testing.T) {
+	setMotion(t, MotionFull)
+
 	state := NewState(WithValue(50), WithMax(100))
 	slider := New(state, WithShowValue(false))
 
@@ -60,6 +67,112 @@ func TestAccessibleSlider_ScreenReaderMode(t *testing.T) {
 	if !accessible.Slider().showValue {
 		t.Error("Screen reader mode should enable value display")
 	}
+
+	if state.MotionPreference() != MotionReduced {
+		t.Error("Screen reader mode should reduce motion for its slider")
+	}
+	if GlobalMotionPreference() != MotionFull {
+		t.Error("Screen reader mode should not change other sliders' motion")
+	}
+
+	clock := NewManualClock(time.Time{})
+	other := NewState(WithValue(0), WithMax(100))
+	manager := NewAnimationManager(WithClock(clock))
+	manager.Start(state, 0, WithAnimDuration(time.Second))
+	manager.Start(other, 100, WithAnimDuration(time.Second))
+	clock.Advance(ReducedMotionFade)
+	manager.Update()
+	if state.Value() != 0 {
+		t.Errorf("Expected the screen reader slider to fade quickly, got %f", state.Value())
+	}
+	if other.Value() >= 100 {
+		t.Error("Other sliders should animate in full")
+	}
+}
+
+func TestAccessibleSlider_ScreenReaderText(t *testing.T) {
+	state := NewState(WithValue(42), WithMax(100), WithStep(1))
+	slider := New(state, WithLabel("Volume"))
+
+	accessible := NewAccessibleSlider(slider,
+		WithAccessibilityMode(AccessibilityScreenReader),
+	)
+	if got := accessible.View(); got != "Volume slider, 42 of 100, 42 percent, step 1" {
+		t.Errorf("Unexpected screen reader view: %q", got)
+	}
+
+	compact := NewAccessibleSlider(slider,
+		WithAccessibilityMode(AccessibilityScreenReader),
+		WithCompactText(true),
+	)
+	if got := compact.View(); got != "Volume: 42/100" {
+		t.Errorf("Unexpected compact view: %q", got)
+	}
+
+	// Fractional steps keep their precision; unlabelled sliders use a name
+	state = NewState(WithValue(0.25), WithMax(1), WithStep(0.05))
+	accessible = NewAccessibleSlider(New(state))
+	if got := accessible.Text(); got != "Slider, 0.2 of 1, 25 percent, step 0.05" {
+		t.Errorf("Unexpected text: %q", got)
+	}
+
+	// Other modes still draw the track (ASCII after screen reader mode)
+	if view := NewAccessibleSlider(slider).View(); !strings.Contains(view, "===") {
+		t.Errorf("Default mode should draw the track, got %q", view)
+	}
+
+	slider.SetIndeterminate(true)
+	if got := compact.View(); got != "Volume slider, busy" {
+		t.Errorf("Unexpected indeterminate text: %q", got)
+	}
+}
+
+func TestSliderGroup_TextView(t *testing.T) {
+	group := NewSliderGroup()
+	group.Add(New(NewState(WithValue(10), WithMax(255)), WithLabel("Red")))
+	group.Add(New(NewState(WithValue(20), WithMax(255)), WithLabel("Green")))
+	group.SetFocused(1)
+
+	expected := "1. Red: 10/255\n2. Green: 20/255, focused"
+	if got := group.TextView(WithCompactText(true)); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	ja, _ := LookupLocale("ja")
+	lines := strings.Split(group.TextView(WithLocale(ja)), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "1. Redスライダー、10 / 255") {
+		t.Errorf("Unexpected Japanese list: %q", lines)
+	}
+
+	lines = strings.Split(group.TextView(WithCompactText(true), WithDescription("Color channel")), "\n")
+	if len(lines) != 2 || lines[1] != "2. Green: 20/255, focused. Color channel" {
+		t.Errorf("Expected descriptions on every line, got %q", lines)
+	}
+
+	// Modes would restyle the sliders, so they are ignored
+	group.TextView(WithAccessibilityMode(AccessibilityASCII))
+	if group.sliders[0].symbols == ASCIISymbols() {
+		t.Error("TextView should not apply modes to the sliders")
+	}
+}
+
+func TestAccessibleSlider_ScreenReaderFocusAndDescription(t *testing.T) {
+	slider := New(NewState(WithValue(42), WithMax(100)), WithLabel("Volume"))
+	accessible := NewAccessibleSlider(slider,
+		WithAccessibilityMode(AccessibilityScreenReader),
+		WithDescription("Adjusts playback volume"),
+	)
+
+	expected := "Volume slider, 42 of 100, 42 percent, step 1. Adjusts playback volume"
+	if got := accessible.View(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	accessible.SetFocused(true)
+	expected = "Volume slider, 42 of 100, 42 percent, step 1, focused. Adjusts playback volume"
+	if got := accessible.View(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
 }
 
 func TestAccessibleSlider_Description(t *testing.T) {
@@ -130,6 +243,137 @@ func TestAccessibleSlider_Announcer(t *testing.T) {
 	}
 }
 
+func TestAccessibleSlider_BoundaryAnnouncements(t *testing.T) {
+	state := NewState(WithValue(80), WithMax(100), WithStep(10))
+	q := NewQueueAnnouncer()
+	accessible := NewAccessibleSlider(New(state), WithAnnouncerSink(q))
+
+	accessible.Increment()
+	accessible.Increment()
+	accessible.Increment() // Already at the maximum
+
+	got := q.Announcements()
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 announcements, got %v", got)
+	}
+	if got[0].Politeness != Polite {
+		t.Errorf("Value changes should be polite, got %v", got[0])
+	}
+	if got[1].Politeness != Assertive || got[1].Message != "100, 100 percent. Maximum value reached" {
+		t.Errorf("Reaching the maximum should be assertive, got %v", got[1])
+	}
+	if got[2].Politeness != Polite || got[2].Message != "Maximum value reached" {
+		t.Errorf("Pushing past the maximum should repeat the boundary politely, got %v", got[2])
+	}
+
+	// Holding the key against the bound doesn't flood the screen reader
+	for range 5 {
+		accessible.Increment()
+	}
+	if n := len(q.Announcements()); n != 3 {
+		t.Errorf("Expected the boundary to be repeated once, got %d announcements", n)
+	}
+
+	// Moving away allows it again
+	accessible.Decrement()
+	accessible.Increment()
+	accessible.Increment()
+	if got := q.Messages(); len(got) != 6 || got[5] != "Maximum value reached" {
+		t.Errorf("Expected the boundary again after moving away, got %q", got)
+	}
+}
+
+func TestAccessibleSlider_AnnounceDebounce(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100), WithStep(1))
+	q := NewQueueAnnouncer()
+	accessible := NewAccessibleSlider(New(state),
+		WithAnnouncerSink(q),
+		WithAnnounceDebounce(time.Hour),
+	)
+
+	// Focus isn't debounced
+	accessible.SetFocused(true)
+	if q.Len() != 1 {
+		t.Fatalf("Expected the focus announcement at once, got %d", q.Len())
+	}
+	q.Clear()
+
+	// Holding a key announces nothing until the value settles
+	for i := 0; i < 20; i++ {
+		accessible.Increment()
+	}
+	if q.Len() != 0 || accessible.FlushAnnouncements() {
+		t.Errorf("Expected value announcements to be held, got %v", q.Messages())
+	}
+	if accessible.AnnouncementTick() == nil {
+		t.Error("Expected a flush to be scheduled")
+	}
+
+	// Losing focus delivers the held announcement
+	accessible.SetFocused(false)
+	if got := q.Messages(); len(got) != 1 || got[0] != "20, 20 percent" {
+		t.Errorf("Expected only the final value, got %v", got)
+	}
+}
+
+func TestAccessibleSlider_Localized(t *testing.T) {
+	de, _ := LookupLocale("de")
+	state := NewState(WithValue(2.5), WithMin(0), WithMax(10), WithStep(0.5))
+	accessible := NewAccessibleSlider(New(state, WithLabel("Lautstärke")),
+		WithLocale(de),
+		WithUnit("Dezibel", "Dezibel"),
+	)
+
+	if got := accessible.GetDescription(); got != "Lautstärke: 2,5 Dezibel von 10 (25\u00a0%)" {
+		t.Errorf("Unexpected description: %q", got)
+	}
+	if got := accessible.GetValueAnnouncement(); got != "2,5 Dezibel, 25 Prozent" {
+		t.Errorf("Unexpected announcement: %q", got)
+	}
+	if got := accessible.GetFocusAnnouncement(); !strings.HasSuffix(got, "Mit den Pfeiltasten anpassen.") {
+		t.Errorf("Unexpected focus announcement: %q", got)
+	}
+
+	state.SetValue(0)
+	if got := accessible.GetBoundaryAnnouncement(); got != "Minimalwert erreicht" {
+		t.Errorf("Unexpected boundary announcement: %q", got)
+	}
+}
+
+func TestAccessibleSlider_AnnouncementsMatchDisplay(t *testing.T) {
+	// Fractional values aren't rounded away
+	state := NewState(WithValue(0.5), WithMax(1), WithStep(0.1))
+	accessible := NewAccessibleSlider(New(state))
+	if got := accessible.GetValueAnnouncement(); got != "0.5, 50 percent" {
+		t.Errorf("Unexpected announcement: %q", got)
+	}
+
+	// A value format is used as is
+	slider := New(state, WithValueFormat("%.2f V"))
+	accessible = NewAccessibleSlider(slider)
+	if got := accessible.GetValueAnnouncement(); got != "0.50 V, 50 percent" {
+		t.Errorf("Expected the displayed value, got %q", got)
+	}
+
+	// Only the decimal separator follows the locale; large values aren't
+	// grouped, as the display doesn't group them
+	de, _ := LookupLocale("de")
+	accessible = NewAccessibleSlider(slider, WithLocale(de))
+	if got := accessible.GetValueAnnouncement(); got != "0,50 V, 50 Prozent" {
+		t.Errorf("Expected the displayed value with a comma, got %q", got)
+	}
+
+	large := New(NewState(WithValue(1500), WithMax(2000)), WithShowValue(true))
+	for _, tag := range []string{"en", "de", "fr"} {
+		l, _ := LookupLocale(tag)
+		announcement := NewAccessibleSlider(large, WithLocale(l)).GetValueAnnouncement()
+		value, _, _ := strings.Cut(announcement, ",")
+		if value != "1500" || !strings.Contains(large.View(), value) {
+			t.Errorf("%s: expected %q as displayed in %q, got %q", tag, "1500", large.View(), announcement)
+		}
+	}
+}
+
 func TestAccessibleSlider_FocusState(t *testing.T) {
 	state := NewState()
 	slider := New(state)
@@ -402,6 +646,24 @@ func TestProgressAnnouncer(t *testing.T) {
 	}
 }
 
+func TestProgressAnnouncer_Locale(t *testing.T) {
+	es, _ := LookupLocale("es")
+	var announced []string
+	announcer := NewProgressAnnouncer(50, func(msg string) {
+		announced = append(announced, msg)
+	}).WithLocale(es)
+
+	state := NewState(WithValue(50), WithMax(100))
+	announcer.Update(state)
+	state.SetValue(100)
+	announcer.Update(state)
+
+	expected := []string{"50 por ciento completado", "Completado"}
+	if strings.Join(announced, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected %v, got %v", expected, announced)
+	}
+}
+
 func TestProgressAnnouncer_Reset(t *testing.T) {
 	var count int
 	announcer := NewProgressAnnouncer(50, func(msg string) {
@@ -455,6 +717,80 @@ func TestContrastChecker(t *testing.T) {
 	}
 }
 
+func TestContrastChecker_Contrast(t *testing.T) {
+	checker := NewContrastChecker(ContrastRatioAA).WithDarkBackground(true)
+
+	tests := []struct {
+		name  string
+		fg    lipgloss.TerminalColor
+		bg    lipgloss.TerminalColor
+		ratio float64
+		level ContrastLevel
+	}{
+		{"black on white", lipgloss.Color("#000000"), lipgloss.Color("#ffffff"), 21, ContrastAAA},
+		{"same color", lipgloss.Color("#336699"), lipgloss.Color("#336699"), 1, ContrastFail},
+		{"ANSI 15 on 0", lipgloss.Color("15"), lipgloss.Color("0"), 21, ContrastAAA},
+		{"ANSI256 gray", lipgloss.Color("244"), lipgloss.Color("0"), 5.32, ContrastAA},
+		{"mid gray on white", lipgloss.Color("#777777"), lipgloss.Color("#ffffff"), 4.48, ContrastAALarge},
+		{"ANSIColor type", lipgloss.ANSIColor(9), lipgloss.Color("#000000"), 5.25, ContrastAA},
+		{"adaptive dark side", lipgloss.AdaptiveColor{Light: "#000000", Dark: "#ffffff"}, lipgloss.Color("0"), 21, ContrastAAA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := checker.Contrast(tt.fg, tt.bg)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if math.Abs(result.Ratio-tt.ratio) > 0.01 {
+				t.Errorf("Expected ratio %.2f, got %.2f", tt.ratio, result.Ratio)
+			}
+			if result.Level != tt.level {
+				t.Errorf("Expected level %s, got %s", tt.level, result.Level)
+			}
+			if result.Pass != (tt.ratio >= ContrastRatioAA) {
+				t.Errorf("Unexpected pass result %v for %s", result.Pass, result)
+			}
+		})
+	}
+
+	if _, err := checker.Contrast(lipgloss.NoColor{}, lipgloss.Color("0")); err == nil {
+		t.Error("NoColor should not resolve")
+	}
+}
+
+func TestContrastChecker_Palette(t *testing.T) {
+	// ANSI blue is much lighter in xterm's palette than the default one
+	def, _ := NewContrastChecker(ContrastRatioAA).Contrast(lipgloss.Color("4"), lipgloss.Color("0"))
+	xterm, _ := NewContrastChecker(ContrastRatioAA).WithPalette(XtermPalette).
+		Contrast(lipgloss.Color("4"), lipgloss.Color("0"))
+	if xterm.Ratio <= def.Ratio {
+		t.Errorf("Expected palette to change the ratio, got %.2f and %.2f", def.Ratio, xterm.Ratio)
+	}
+
+	// ANSI256 colors above 15 don't depend on the palette
+	a, _ := NewContrastChecker(ContrastRatioAA).Contrast(lipgloss.Color("196"), lipgloss.Color("0"))
+	b, _ := NewContrastChecker(ContrastRatioAA).WithPalette(XtermPalette).
+		Contrast(lipgloss.Color("196"), lipgloss.Color("0"))
+	if a.Ratio != b.Ratio {
+		t.Error("Palette should only affect ANSI colors 0-15")
+	}
+}
+
+func TestContrastChecker_CheckColorsFormats(t *testing.T) {
+	checker := NewContrastChecker(ContrastRatioAA)
+
+	if ok, msg := checker.CheckColors("#777777", "#ffffff"); ok || !strings.Contains(msg, "4.48:1") {
+		t.Errorf("Expected mid gray on white to fail with its ratio, got %v %q", ok, msg)
+	}
+	if ok, _ := checker.CheckColors("navy", "#ffffff"); !ok {
+		t.Error("Navy on white should pass")
+	}
+	if ok, msg := checker.CheckColors("wobble", "black"); ok || !strings.Contains(msg, "Unknown") {
+		t.Errorf("Unknown colors should fail, got %q", msg)
+	}
+}
+
 func TestContrastChecker_SuggestHighContrast(t *testing.T) {
 	checker := NewContrastChecker(4.5)
 
@@ -463,11 +799,11 @@ func TestContrastChecker_SuggestHighContrast(t *testing.T) {
 		expected string
 	}{
 		{"black", "white"},
-		{"dark", "white"},
 		{"darkblue", "white"},
+		{"navy", "white"},
 		{"white", "black"},
-		{"light", "black"},
 		{"lightyellow", "black"},
+		{"#888888", "black"},
 	}
 
 	for _, tt := range tests {
@@ -477,3 +813,140 @@ func TestContrastChecker_SuggestHighContrast(t *testing.T) {
 		}
 	}
 }
+
+func TestContrastChecker_SuggestHighContrastColor(t *testing.T) {
+	checker := NewContrastChecker(4.5)
+
+	tests := []struct {
+		bg       lipgloss.Color
+		expected lipgloss.Color
+	}{
+		{"0", "#ffffff"},
+		{"#1a1a2e", "#ffffff"},
+		{"4", "#ffffff"},
+		{"15", "#000000"},
+		{"#ffffe0", "#000000"},
+		{"#888888", "#000000"},
+	}
+
+	for _, tt := range tests {
+		suggestion := checker.SuggestHighContrastColor(tt.bg)
+		if suggestion != tt.expected {
+			t.Errorf("For bg=%s, expected %s, got %s", tt.bg, tt.expected, suggestion)
+		}
+		if result, _ := checker.Contrast(suggestion, tt.bg); !result.Pass {
+			t.Errorf("Suggestion %s on %s should pass, got %s", suggestion, tt.bg, result)
+		}
+	}
+}
+
+func TestContrastChecker_DetectsBackgroundLazily(t *testing.T) {
+	checker := NewContrastChecker(ContrastRatioAA)
+	checker.Contrast(lipgloss.Color("15"), lipgloss.ANSIColor(0))
+	checker.EnsureContrast(lipgloss.Color("#000080"), lipgloss.Color("#000000"))
+	if checker.darkBackground != nil {
+		t.Error("Plain colors should not query the terminal background")
+	}
+
+	checker.WithDarkBackground(false)
+	result, _ := checker.Contrast(lipgloss.AdaptiveColor{Light: "#000000", Dark: "#ffffff"}, lipgloss.Color("#ffffff"))
+	if result.Ratio != 21 {
+		t.Errorf("Expected the light side on a light background, got %s", result)
+	}
+}
+
+func TestContrastChecker_EnsureContrast(t *testing.T) {
+	checker := NewContrastChecker(ContrastRatioAA)
+	bg := lipgloss.Color("#000000")
+
+	// Passing colors are kept
+	if got := checker.EnsureContrast(lipgloss.Color("15"), bg); got != lipgloss.Color("15") {
+		t.Errorf("Passing color should be unchanged, got %v", got)
+	}
+
+	// Dark blue on black is lightened just enough
+	got := checker.EnsureContrast(lipgloss.Color("#000080"), bg)
+	result, _ := checker.Contrast(got, bg)
+	if !result.Pass || result.Ratio > 4.7 {
+		t.Errorf("Expected a color just past 4.5:1, got %v at %s", got, result)
+	}
+}
+
+func TestHighContrastPalettes_MeetAAA(t *testing.T) {
+	checker := NewContrastChecker(ContrastRatioAAA)
+	for _, p := range []HighContrastPalette{DefaultHighContrastPalette(), DarkHighContrastPalette(), LightHighContrastPalette()} {
+		result, err := checker.Contrast(p.Foreground, p.Background)
+		if err != nil || !result.Pass {
+			t.Errorf("Palette foreground %s on %s: %s", p.Foreground, p.Background, result)
+		}
+	}
+}
+
+func TestAccessibleSlider_Locked(t *testing.T) {
+	queue := &QueueAnnouncer{}
+	slider := New(NewState(WithValue(50), WithMax(100)), WithLabel("Volume"), WithReadOnly(true))
+	accessible := NewAccessibleSlider(slider, WithAnnouncerSink(queue))
+
+	accessible.Increment()
+	accessible.SetValue(10)
+	if !accessible.HandleKey(tea.KeyMsg{Type: tea.KeyEnd}) {
+		t.Error("Read-only sliders should handle their keys")
+	}
+	if v := slider.state.Value(); v != 50 {
+		t.Errorf("Read-only slider should keep its value, got %v", v)
+	}
+	if msgs := queue.Messages(); len(msgs) != 1 || msgs[0] != "50, 50 percent, read-only" {
+		t.Errorf("Repeated key presses should announce the state once, got %q", msgs)
+	}
+
+	expected := "Volume slider, 50 of 100, 50 percent, step 1, read-only"
+	if text := accessible.Text(); text != expected {
+		t.Errorf("Expected %q, got %q", expected, text)
+	}
+
+	queue.Clear()
+	accessible.SetFocused(true)
+	if msgs := queue.Messages(); len(msgs) != 1 || msgs[0] != "Volume: 50 of 100 (50%), read-only" {
+		t.Errorf("Unexpected focus announcement: %q", msgs)
+	}
+
+	slider.SetReadOnly(false)
+	slider.SetDisabled(true)
+	if accessible.HandleKey(tea.KeyMsg{Type: tea.KeyRight}) {
+		t.Error("Disabled sliders should ignore keys")
+	}
+	if text := accessible.CompactText(); text != "Volume: 50/100, disabled" {
+		t.Errorf("Unexpected compact text %q", text)
+	}
+	if states := accessible.InteractionStates(); len(states) != 2 || states[1] != InteractionDisabled {
+		t.Errorf("Expected the disabled state, got %v", states)
+	}
+
+	de, _ := LookupLocale("de")
+	if text := NewAccessibleSlider(slider, WithLocale(de)).CompactText(); text != "Volume: 50/100, deaktiviert" {
+		t.Errorf("Unexpected German text %q", text)
+	}
+}
+
+func TestAccessibleSlider_HandleKey(t *testing.T) {
+	state := NewState(WithValue(50), WithMax(100))
+	accessible := NewAccessibleSlider(New(state))
+
+	keys := []struct {
+		msg      tea.KeyMsg
+		expected float64
+	}{
+		{tea.KeyMsg{Type: tea.KeyRight}, 51},
+		{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")}, 50},
+		{tea.KeyMsg{Type: tea.KeyEnd}, 100},
+		{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("0")}, 0},
+	}
+	for _, k := range keys {
+		if !accessible.HandleKey(k.msg) || state.Value() != k.expected {
+			t.Errorf("%s: expected %v, got %v", k.msg, k.expected, state.Value())
+		}
+	}
+	if accessible.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}) {
+		t.Error("Other keys should not be handled")
+	}
+}
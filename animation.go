@@ -2,6 +2,8 @@ package tuslide
 
 import (
 	"math"
+	"sort"
+	"sync/atomic"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -119,8 +121,148 @@ func EaseInBounce(t float64) float64 {
 	return 1 - EaseOutBounce(1-t)
 }
 
+// DefaultFPS is the frame rate used by animations and managers that don't
+// configure one.
+const DefaultFPS = 60
+
+// Animation and manager IDs are unique across the program so tick messages
+// can be routed to the animator that requested them. Zero means "none".
+var (
+	lastAnimationID atomic.Int64
+	lastManagerID   atomic.Int64
+)
+
+// NewAnimationID returns a new program-wide animation ID. Custom Animator
+// implementations use it so their ticks and completions can be routed.
+func NewAnimationID() int {
+	return int(lastAnimationID.Add(1))
+}
+
+// Animator is a value animation that advances one frame at a time.
+// Animation, PulseAnimation and SpringAnimation implement it, and an
+// AnimationManager can run any Animator, including custom ones.
+type Animator interface {
+	// ID returns the animator's program-wide unique ID (see NewAnimationID).
+	ID() int
+	// State returns the slider state the animator drives.
+	State() *SliderState
+	// Update advances the animator by one frame. Returns true once finished.
+	Update() bool
+}
+
+// multiStateAnimator is implemented by animators that drive several
+// states, such as Timeline.
+type multiStateAnimator interface {
+	States() []*SliderState
+}
+
+// animatorStates returns the states an animator drives.
+func animatorStates(a Animator) []*SliderState {
+	if ms, ok := a.(multiStateAnimator); ok {
+		return ms.States()
+	}
+	if state := a.State(); state != nil {
+		return []*SliderState{state}
+	}
+	return nil
+}
+
+// stateWatcher is implemented by animators that follow a state without
+// changing it, such as ThresholdColors. They replace each other, but not
+// the animators driving the state.
+type stateWatcher interface {
+	watchesState()
+}
+
+// isWatcher reports whether a only follows its state.
+func isWatcher(a Animator) bool {
+	_, ok := a.(stateWatcher)
+	return ok
+}
+
+// presentationEffect is implemented by animators that draw an effect on a
+// slider without changing any value, such as ColorFade and Marquee.
+// Starting one replaces the running effect with the same key, and leaves
+// the animators of the slider's state alone.
+type presentationEffect interface {
+	effect() effectKey
+}
+
+// effectKey identifies what an effect draws: the kind of effect, and the
+// slider or presentation it draws on.
+type effectKey struct {
+	target any
+	kind   string
+	part   StylePart
+}
+
+// sameEffect reports whether a and b draw the same effect.
+func sameEffect(a, b Animator) bool {
+	ea, ok := a.(presentationEffect)
+	if !ok {
+		return false
+	}
+	eb, ok := b.(presentationEffect)
+	return ok && ea.effect() == eb.effect()
+}
+
+// drives reports whether a drives state.
+func drives(a Animator, state *SliderState) bool {
+	for _, s := range animatorStates(a) {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// Pauser is implemented by animators whose progress depends on wall-clock
+// time, so pausing them doesn't make them jump ahead when resumed.
+type Pauser interface {
+	Pause()
+	Resume()
+}
+
+// animatorTick returns a command for the next frame of a standalone animator.
+func animatorTick(id, fps int) tea.Cmd {
+	return frameTick(fps, func(t time.Time) tea.Msg {
+		return AnimationTickMsg{ID: id, Time: t}
+	})
+}
+
+// handleAnimatorTick advances a standalone animator if msg is a tick
+// addressed to it and returns the command for its next frame, or an
+// AnimationCompleteMsg once it finishes.
+func handleAnimatorTick(a Animator, msg tea.Msg, fps int) tea.Cmd {
+	tick, ok := msg.(AnimationTickMsg)
+	if !ok || tick.ID != a.ID() || tick.ManagerID != 0 {
+		return nil
+	}
+	if a.Update() {
+		return completeCmd(a.ID(), 0)
+	}
+	return animatorTick(a.ID(), fps)
+}
+
+// frameInterval returns the frame duration for the given frame rate.
+func frameInterval(fps int) time.Duration {
+	if fps <= 0 {
+		fps = DefaultFPS
+	}
+	return time.Second / time.Duration(fps)
+}
+
+// frameTick returns a command that fires on the next frame of the shared
+// frame clock. Ticks are aligned to the system clock, so every animator
+// running at the same frame rate advances on the same frames instead of
+// drifting apart.
+func frameTick(fps int, msg func(time.Time) tea.Msg) tea.Cmd {
+	return tea.Every(frameInterval(fps), msg)
+}
+
 // Animation represents an in-progress value animation.
 type Animation struct {
+	id         int
 	state      *SliderState
 	startValue float64
 	endValue   float64
@@ -128,6 +270,11 @@ type Animation struct {
 	duration   time.Duration
 	easing     EasingFunc
 	onComplete func()
+	fps        int
+	clock      Clock
+	paused     bool
+	pausedAt   time.Time
+	motionSetting
 }
 
 // AnimationOption configures an Animation.
@@ -154,33 +301,84 @@ func WithOnComplete(f func()) AnimationOption {
 	}
 }
 
+// WithAnimFPS sets the frame rate used by the animation's Tick.
+func WithAnimFPS(fps int) AnimationOption {
+	return func(a *Animation) {
+		if fps > 0 {
+			a.fps = fps
+		}
+	}
+}
+
+// WithAnimClock sets the clock the animation reads time from.
+func WithAnimClock(c Clock) AnimationOption {
+	return func(a *Animation) {
+		a.clock = clockOrReal(c)
+	}
+}
+
+// WithAnimMotion sets the animation's motion preference, overriding its
+// manager's and the global one.
+func WithAnimMotion(p MotionPreference) AnimationOption {
+	return func(a *Animation) {
+		a.motion = p
+	}
+}
+
 // NewAnimation creates a new animation for a slider state.
 func NewAnimation(state *SliderState, targetValue float64, opts ...AnimationOption) *Animation {
 	a := &Animation{
-		state:      state,
-		startValue: state.Value(),
-		endValue:   targetValue,
-		startTime:  time.Now(),
-		duration:   300 * time.Millisecond,
-		easing:     EaseOutQuad,
+		id:            NewAnimationID(),
+		state:         state,
+		startValue:    state.Value(),
+		endValue:      targetValue,
+		duration:      300 * time.Millisecond,
+		easing:        EaseOutQuad,
+		fps:           DefaultFPS,
+		clock:         RealClock,
+		motionSetting: motionFor(state),
 	}
 
 	for _, opt := range opts {
 		opt(a)
 	}
+	a.startTime = a.clock.Now()
 
 	return a
 }
 
+// AnimationCompleteMsg is sent when an animation driven by HandleTick
+// finishes.
+type AnimationCompleteMsg struct {
+	ID        int // Animation that completed
+	ManagerID int // AnimationManager that ran it (0 if standalone)
+}
+
+// completeCmd returns a command delivering an AnimationCompleteMsg.
+func completeCmd(id, managerID int) tea.Cmd {
+	return func() tea.Msg {
+		return AnimationCompleteMsg{ID: id, ManagerID: managerID}
+	}
+}
+
 // AnimationTickMsg is sent to update animation progress.
+// Ticks requested by a standalone animation carry its ID; ticks requested
+// by an AnimationManager carry the manager's ID instead.
 type AnimationTickMsg struct {
-	ID int
+	ID        int       // Animation that requested the tick (0 if none)
+	ManagerID int       // AnimationManager that requested the tick (0 if none)
+	Time      time.Time // Frame time
 }
 
 // Update advances the animation and returns true if complete.
 func (a *Animation) Update() bool {
-	elapsed := time.Since(a.startTime)
-	if elapsed >= a.duration {
+	if a.paused {
+		return false
+	}
+
+	duration, easing := a.timing()
+	elapsed := a.clock.Now().Sub(a.startTime)
+	if elapsed >= duration {
 		a.state.SetValue(a.endValue)
 		if a.onComplete != nil {
 			a.onComplete()
@@ -189,8 +387,8 @@ func (a *Animation) Update() bool {
 	}
 
 	// Calculate eased progress
-	progress := float64(elapsed) / float64(a.duration)
-	easedProgress := a.easing(progress)
+	progress := float64(elapsed) / float64(duration)
+	easedProgress := easing(progress)
 
 	// Interpolate value
 	value := a.startValue + (a.endValue-a.startValue)*easedProgress
@@ -199,95 +397,462 @@ func (a *Animation) Update() bool {
 	return false
 }
 
+// timing returns the duration and easing to use under the animation's
+// motion preference.
+func (a *Animation) timing() (time.Duration, EasingFunc) {
+	return motionTiming(a.effectiveMotion(), a.duration, a.easing)
+}
+
+// ID returns the animation's program-wide unique ID.
+func (a *Animation) ID() int {
+	return a.id
+}
+
+// State returns the slider state being animated.
+func (a *Animation) State() *SliderState {
+	return a.state
+}
+
+// Retarget restarts the animation from the state's current value toward a
+// new target, keeping its duration and easing.
+func (a *Animation) Retarget(targetValue float64) {
+	a.startValue = a.state.Value()
+	a.endValue = targetValue
+	a.startTime = a.clock.Now()
+	a.pausedAt = a.startTime
+}
+
+// override applies the settings opts set to a running animation. The
+// clock is kept, as the animation's times are measured on it.
+func (a *Animation) override(opts []AnimationOption) {
+	var set Animation
+	for _, opt := range opts {
+		opt(&set)
+	}
+	if set.duration != 0 {
+		a.duration = set.duration
+	}
+	if set.easing != nil {
+		a.easing = set.easing
+	}
+	if set.onComplete != nil {
+		a.onComplete = set.onComplete
+	}
+	if set.fps != 0 {
+		a.fps = set.fps
+	}
+	if set.motion != MotionDefault {
+		a.motion = set.motion
+	}
+}
+
+// Pause freezes the animation at its current value.
+func (a *Animation) Pause() {
+	if !a.paused {
+		a.paused = true
+		a.pausedAt = a.clock.Now()
+	}
+}
+
+// Resume continues a paused animation from where it left off.
+func (a *Animation) Resume() {
+	if a.paused {
+		a.paused = false
+		a.startTime = a.startTime.Add(a.clock.Now().Sub(a.pausedAt))
+	}
+}
+
 // Tick returns a command that triggers the next animation frame.
 func (a *Animation) Tick() tea.Cmd {
-	return tea.Tick(16*time.Millisecond, func(t time.Time) tea.Msg {
-		return AnimationTickMsg{}
-	})
+	return animatorTick(a.id, a.fps)
+}
+
+// HandleTick advances the animation if msg is a tick addressed to it and
+// returns the command for the next frame. Once the animation completes it
+// returns a command delivering AnimationCompleteMsg instead.
+// Messages for other animators are ignored.
+func (a *Animation) HandleTick(msg tea.Msg) tea.Cmd {
+	return handleAnimatorTick(a, msg, a.fps)
 }
 
 // IsComplete returns true if the animation has finished.
 func (a *Animation) IsComplete() bool {
-	return time.Since(a.startTime) >= a.duration
+	duration, _ := a.timing()
+	if a.paused {
+		return a.pausedAt.Sub(a.startTime) >= duration
+	}
+	return a.clock.Now().Sub(a.startTime) >= duration
 }
 
 // AnimationManager manages multiple concurrent animations.
+// It keeps at most one tick outstanding, so starting several animations
+// doesn't multiply the frame rate, and stops ticking when idle.
+// Each SliderState is driven by at most one animator at a time.
 type AnimationManager struct {
-	animations map[int]*Animation
-	nextID     int
+	id         int
+	animations map[int]Animator
+	paused     map[int]bool
+	fps        int
+	clock      Clock
+	motion     MotionPreference
+	ticking    bool // A tick has been requested and not yet handled
+}
+
+// ManagerOption configures an AnimationManager.
+type ManagerOption func(*AnimationManager)
+
+// WithFPS sets the manager's frame rate.
+func WithFPS(fps int) ManagerOption {
+	return func(m *AnimationManager) {
+		m.SetFPS(fps)
+	}
+}
+
+// WithClock sets the clock used by animations the manager creates
+// (Start and AnimateTo). Animators passed to Run keep their own clock.
+func WithClock(c Clock) ManagerOption {
+	return func(m *AnimationManager) {
+		m.clock = clockOrReal(c)
+	}
+}
+
+// WithMotion sets the manager's motion preference, overriding the global
+// one for every animation it runs.
+func WithMotion(p MotionPreference) ManagerOption {
+	return func(m *AnimationManager) {
+		m.motion = p
+	}
 }
 
 // NewAnimationManager creates a new animation manager.
-func NewAnimationManager() *AnimationManager {
-	return &AnimationManager{
-		animations: make(map[int]*Animation),
+func NewAnimationManager(opts ...ManagerOption) *AnimationManager {
+	m := &AnimationManager{
+		id:         int(lastManagerID.Add(1)),
+		animations: make(map[int]Animator),
+		paused:     make(map[int]bool),
+		fps:        DefaultFPS,
+		clock:      RealClock,
 	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// ID returns the manager's program-wide unique ID.
+func (m *AnimationManager) ID() int {
+	return m.id
 }
 
-// Start begins a new animation and returns its ID.
+// FPS returns the manager's frame rate.
+func (m *AnimationManager) FPS() int {
+	return m.fps
+}
+
+// SetFPS changes the manager's frame rate. Non-positive values are ignored.
+func (m *AnimationManager) SetFPS(fps int) {
+	if fps > 0 {
+		m.fps = fps
+	}
+}
+
+// Start begins a new tween animation on the manager's clock and returns
+// its ID. Any animator already driving state is replaced.
 func (m *AnimationManager) Start(state *SliderState, targetValue float64, opts ...AnimationOption) int {
-	id := m.nextID
-	m.nextID++
-	m.animations[id] = NewAnimation(state, targetValue, opts...)
-	return id
+	opts = append([]AnimationOption{WithAnimClock(m.clock)}, opts...)
+	return m.Run(NewAnimation(state, targetValue, opts...))
+}
+
+// Clock returns the manager's clock.
+func (m *AnimationManager) Clock() Clock {
+	return m.clock
+}
+
+// MotionPreference returns the manager's effective motion preference.
+func (m *AnimationManager) MotionPreference() MotionPreference {
+	if m.motion == MotionDefault {
+		return GlobalMotionPreference()
+	}
+	return m.motion
+}
+
+// SetMotionPreference changes the manager's motion preference, including
+// for animations already running. MotionDefault follows the global one.
+func (m *AnimationManager) SetMotionPreference(p MotionPreference) {
+	m.motion = p
+	for _, a := range m.animations {
+		if mi, ok := a.(motionInheritor); ok {
+			mi.inheritMotion(p)
+		}
+	}
+}
+
+// Run adds any Animator to the manager and returns its ID.
+// Any other animator driving the same state, or one of the states of a
+// Timeline, is replaced. Animators that only follow a state, such as
+// ThresholdColors, replace and are replaced by their own kind, and
+// effects such as ColorFade or Marquee replace the same effect on the
+// same slider.
+func (m *AnimationManager) Run(a Animator) int {
+	for id, other := range m.animations {
+		if id != a.ID() && sameEffect(other, a) {
+			m.Cancel(id)
+		}
+	}
+	for _, state := range animatorStates(a) {
+		for id, other := range m.animations {
+			if id != a.ID() && isWatcher(other) == isWatcher(a) && drives(other, state) {
+				m.Cancel(id)
+			}
+		}
+	}
+
+	if mi, ok := a.(motionInheritor); ok {
+		mi.inheritMotion(m.motion)
+	}
+
+	m.animations[a.ID()] = a
+	return a.ID()
+}
+
+// Get returns a running animator by ID.
+func (m *AnimationManager) Get(id int) (Animator, bool) {
+	a, ok := m.animations[id]
+	return a, ok
+}
+
+// find returns the animator driving state, if any.
+func (m *AnimationManager) find(state *SliderState) (Animator, int, bool) {
+	if state == nil {
+		return nil, 0, false
+	}
+	for id, a := range m.animations {
+		if !isWatcher(a) && drives(a, state) {
+			return a, id, true
+		}
+	}
+	return nil, 0, false
+}
+
+// AnimateTo animates state to targetValue and returns the animation ID and
+// the command for the next frame. If state is already being tweened by
+// this manager, that animation is retargeted from the current value and
+// keeps its ID, so repeated calls never fight over the same state; a
+// paused tween is resumed. Options given override the tween's settings,
+// except its clock. Any other kind of animator driving state is replaced.
+func (m *AnimationManager) AnimateTo(state *SliderState, targetValue float64, opts ...AnimationOption) (int, tea.Cmd) {
+	if a, id, ok := m.find(state); ok {
+		if anim, ok := a.(*Animation); ok {
+			anim.override(opts)
+			cmd := m.Resume(id)
+			anim.Retarget(targetValue)
+			if cmd == nil {
+				cmd = m.Tick()
+			}
+			return id, cmd
+		}
+	}
+
+	return m.Start(state, targetValue, opts...), m.Tick()
+}
+
+// Retarget points a running tween at a new target value.
+// Returns false if no tween has the given ID.
+func (m *AnimationManager) Retarget(id int, targetValue float64) bool {
+	anim, ok := m.animations[id].(*Animation)
+	if ok {
+		anim.Retarget(targetValue)
+	}
+	return ok
+}
+
+// Pause freezes an animation at its current frame.
+// Returns false if no animation has the given ID.
+func (m *AnimationManager) Pause(id int) bool {
+	a, ok := m.animations[id]
+	if !ok {
+		return false
+	}
+	if p, ok := a.(Pauser); ok && !m.paused[id] {
+		p.Pause()
+	}
+	m.paused[id] = true
+	return true
+}
+
+// Resume continues a paused animation and returns the command for the next
+// frame, or nil if the clock is already running or nothing was paused.
+func (m *AnimationManager) Resume(id int) tea.Cmd {
+	if !m.paused[id] {
+		return nil
+	}
+	delete(m.paused, id)
+	if p, ok := m.animations[id].(Pauser); ok {
+		p.Resume()
+	}
+	return m.Tick()
+}
+
+// IsPaused returns true if the animation with the given ID is paused.
+func (m *AnimationManager) IsPaused(id int) bool {
+	return m.paused[id]
 }
 
 // Update advances all animations and removes completed ones.
 // Returns true if any animations are still running.
+// Update consumes the outstanding tick, so call it once per received tick;
+// prefer HandleTick, which also ignores ticks meant for other animators.
 func (m *AnimationManager) Update() bool {
-	for id, anim := range m.animations {
-		if anim.Update() {
+	running, _ := m.advance()
+	return running
+}
+
+// advance steps every unpaused animation by one frame and returns whether
+// any are still running along with the IDs of those that completed.
+func (m *AnimationManager) advance() (bool, []int) {
+	m.ticking = false
+
+	// Update in ID (creation) order, so animators that follow a state,
+	// like ThresholdColors, see it at the same point in every frame
+	ids := make([]int, 0, len(m.animations))
+	for id := range m.animations {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var done []int
+	for _, id := range ids {
+		// Earlier animators' callbacks may have cancelled this one
+		a, ok := m.animations[id]
+		if !ok || m.paused[id] {
+			continue
+		}
+		if a.Update() {
 			delete(m.animations, id)
+			done = append(done, id)
 		}
 	}
-	return len(m.animations) > 0
+
+	return m.IsRunning(), done
 }
 
 // Tick returns a command that triggers the next animation frame.
+// Returns nil when nothing is running or a tick is already outstanding,
+// so it is safe to call after every Start.
 func (m *AnimationManager) Tick() tea.Cmd {
-	if len(m.animations) == 0 {
+	if !m.IsRunning() || m.ticking {
 		return nil
 	}
-	return tea.Tick(16*time.Millisecond, func(t time.Time) tea.Msg {
-		return AnimationTickMsg{}
+	m.ticking = true
+
+	id := m.id
+	return frameTick(m.fps, func(t time.Time) tea.Msg {
+		return AnimationTickMsg{ManagerID: id, Time: t}
 	})
 }
 
-// Cancel stops an animation by ID.
+// HandleTick advances all animations if msg is a tick addressed to this
+// manager and returns the command for the next frame, batched with an
+// AnimationCompleteMsg for every animation that finished on this frame.
+// It returns nil for other messages, and the clock stops once every
+// animation has finished or is paused.
+func (m *AnimationManager) HandleTick(msg tea.Msg) tea.Cmd {
+	tick, ok := msg.(AnimationTickMsg)
+	if !ok || tick.ManagerID != m.id {
+		return nil
+	}
+
+	running, done := m.advance()
+
+	cmds := make([]tea.Cmd, 0, len(done)+1)
+	for _, id := range done {
+		cmds = append(cmds, completeCmd(id, m.id))
+	}
+	if running {
+		cmds = append(cmds, m.Tick())
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// Cancel stops an animation by ID, leaving the state at its current value.
 func (m *AnimationManager) Cancel(id int) {
 	delete(m.animations, id)
+	delete(m.paused, id)
+}
+
+// CancelState stops any animation driving state.
+// Returns false if state wasn't being animated.
+func (m *AnimationManager) CancelState(state *SliderState) bool {
+	_, id, ok := m.find(state)
+	if ok {
+		m.Cancel(id)
+	}
+	return ok
 }
 
 // CancelAll stops all animations.
 func (m *AnimationManager) CancelAll() {
-	m.animations = make(map[int]*Animation)
+	m.animations = make(map[int]Animator)
+	m.paused = make(map[int]bool)
 }
 
-// IsRunning returns true if any animations are running.
+// IsRunning returns true if any unpaused animations are running.
 func (m *AnimationManager) IsRunning() bool {
-	return len(m.animations) > 0
+	return len(m.animations) > len(m.paused)
 }
 
-// Count returns the number of running animations.
+// Count returns the number of animations, including paused ones.
 func (m *AnimationManager) Count() int {
 	return len(m.animations)
 }
 
+// animateToManager runs the animations started by AnimateTo, and by
+// AnimateToWith without a manager. Like any manager it is only touched
+// from the Update loop.
+var animateToManager = NewAnimationManager()
+
 // AnimateTo is a convenience function that returns a Bubble Tea command
 // to animate a slider to a target value.
+//
+// The animation advances on the Update loop: pass every message to
+// HandleAnimateTo and return its command. Calling AnimateTo again for the
+// same state retargets the running animation, and CancelAnimateTo stops
+// it. An AnimationCompleteMsg carrying the animation ID is delivered when
+// the target is reached. AnimateTo runs on a package-wide manager and
+// follows the global motion preference; use AnimateToWith to run it on a
+// manager of your own.
 func AnimateTo(state *SliderState, targetValue float64, duration time.Duration, easing EasingFunc) tea.Cmd {
-	anim := NewAnimation(state, targetValue,
+	return AnimateToWith(nil, state, targetValue, duration, easing)
+}
+
+// AnimateToWith is AnimateTo on the given manager, e.g. to keep separate
+// programs or components apart, or to use the manager's clock and motion
+// preference. Pass its messages to manager.HandleTick and stop it with
+// manager.CancelState. A nil manager uses the one AnimateTo does.
+func AnimateToWith(manager *AnimationManager, state *SliderState, targetValue float64, duration time.Duration, easing EasingFunc) tea.Cmd {
+	if manager == nil {
+		manager = animateToManager
+	}
+	_, cmd := manager.AnimateTo(state, targetValue,
 		WithAnimDuration(duration),
 		WithEasing(easing),
 	)
+	return cmd
+}
 
-	return func() tea.Msg {
-		for !anim.IsComplete() {
-			anim.Update()
-			time.Sleep(16 * time.Millisecond)
-		}
-		return AnimationTickMsg{ID: -1} // Signal completion
-	}
+// HandleAnimateTo advances animations started by AnimateTo when msg is one
+// of their ticks and returns the command for the next frame.
+func HandleAnimateTo(msg tea.Msg) tea.Cmd {
+	return animateToManager.HandleTick(msg)
+}
+
+// CancelAnimateTo stops the AnimateTo animation driving state, leaving it
+// at its current value. Returns false if state wasn't being animated.
+func CancelAnimateTo(state *SliderState) bool {
+	return animateToManager.CancelState(state)
 }
 
 // Lerp performs linear interpolation between two values.
@@ -320,29 +885,61 @@ func SmootherStep(t float64) float64 {
 
 // PulseAnimation creates a pulsing effect that oscillates the value.
 type PulseAnimation struct {
+	id        int
 	state     *SliderState
 	baseValue float64
 	amplitude float64
 	frequency float64 // cycles per second
 	startTime time.Time
 	duration  time.Duration // 0 for infinite
+	clock     Clock
+	paused    bool
+	pausedAt  time.Time
+	motionSetting
 }
 
 // NewPulseAnimation creates a new pulsing animation.
 func NewPulseAnimation(state *SliderState, amplitude, frequency float64, duration time.Duration) *PulseAnimation {
 	return &PulseAnimation{
-		state:     state,
-		baseValue: state.Value(),
-		amplitude: amplitude,
-		frequency: frequency,
-		startTime: time.Now(),
-		duration:  duration,
+		id:            NewAnimationID(),
+		state:         state,
+		baseValue:     state.Value(),
+		amplitude:     amplitude,
+		frequency:     frequency,
+		startTime:     RealClock.Now(),
+		duration:      duration,
+		clock:         RealClock,
+		motionSetting: motionFor(state),
 	}
 }
 
+// WithMotion sets the pulse's motion preference. Under reduced motion the
+// pulse doesn't oscillate at all and finishes at its base value.
+func (p *PulseAnimation) WithMotion(pref MotionPreference) *PulseAnimation {
+	p.motion = pref
+	return p
+}
+
+// WithClock sets the clock the pulse reads time from and restarts it.
+func (p *PulseAnimation) WithClock(c Clock) *PulseAnimation {
+	p.clock = clockOrReal(c)
+	p.startTime = p.clock.Now()
+	p.pausedAt = p.startTime
+	return p
+}
+
 // Update advances the pulse animation. Returns true if complete.
 func (p *PulseAnimation) Update() bool {
-	elapsed := time.Since(p.startTime)
+	if p.paused {
+		return false
+	}
+
+	if p.effectiveMotion() != MotionFull {
+		p.state.SetValue(p.baseValue)
+		return true
+	}
+
+	elapsed := p.clock.Now().Sub(p.startTime)
 
 	if p.duration > 0 && elapsed >= p.duration {
 		p.state.SetValue(p.baseValue)
@@ -357,35 +954,150 @@ func (p *PulseAnimation) Update() bool {
 	return false
 }
 
+// ID returns the animation's program-wide unique ID.
+func (p *PulseAnimation) ID() int {
+	return p.id
+}
+
+// State returns the slider state being animated.
+func (p *PulseAnimation) State() *SliderState {
+	return p.state
+}
+
+// Pause freezes the pulse at its current value.
+func (p *PulseAnimation) Pause() {
+	if !p.paused {
+		p.paused = true
+		p.pausedAt = p.clock.Now()
+	}
+}
+
+// Resume continues a paused pulse from where it left off.
+func (p *PulseAnimation) Resume() {
+	if p.paused {
+		p.paused = false
+		p.startTime = p.startTime.Add(p.clock.Now().Sub(p.pausedAt))
+	}
+}
+
 // Tick returns a command for the next pulse frame.
 func (p *PulseAnimation) Tick() tea.Cmd {
-	return tea.Tick(16*time.Millisecond, func(t time.Time) tea.Msg {
-		return AnimationTickMsg{}
-	})
+	return animatorTick(p.id, DefaultFPS)
+}
+
+// HandleTick advances the pulse if msg is a tick addressed to it and
+// returns the command for the next frame, or an AnimationCompleteMsg once
+// it completes.
+func (p *PulseAnimation) HandleTick(msg tea.Msg) tea.Cmd {
+	return handleAnimatorTick(p, msg, DefaultFPS)
+}
+
+// SpringConfig describes a spring's physical properties.
+type SpringConfig struct {
+	Mass         float64 // Inertia: heavier springs respond more slowly
+	Stiffness    float64 // Pull toward the target (higher = faster)
+	DampingRatio float64 // 1 = critical damping, below 1 oscillates, above 1 creeps
 }
 
+// Spring presets.
+var (
+	// SpringGentle settles smoothly with a barely visible overshoot.
+	SpringGentle = SpringConfig{Mass: 1, Stiffness: 120, DampingRatio: 0.65}
+	// SpringWobbly overshoots and oscillates a few times before settling.
+	SpringWobbly = SpringConfig{Mass: 1, Stiffness: 180, DampingRatio: 0.3}
+	// SpringStiff snaps quickly to the target with almost no overshoot.
+	SpringStiff = SpringConfig{Mass: 1, Stiffness: 400, DampingRatio: 0.9}
+)
+
+const (
+	// springTimestep is the fixed simulation step, so springs behave the
+	// same at every frame rate.
+	springTimestep = time.Second / 240
+	// springMaxCatchUp caps how much time one Update simulates, so a long
+	// stall doesn't freeze the UI while the spring catches up.
+	springMaxCatchUp = time.Second
+	// flingProjection is how far ahead a fling projects its release
+	// velocity to pick a resting point.
+	flingProjection = 0.2 // seconds
+)
+
 // SpringAnimation simulates spring physics for natural-feeling motion.
+// The damped harmonic oscillator is solved analytically in fixed time
+// steps, so it is stable for stiff springs and independent of frame rate.
 type SpringAnimation struct {
-	state       *SliderState
-	target      float64
-	velocity    float64
-	stiffness   float64 // Spring stiffness (higher = faster)
-	damping     float64 // Damping ratio (1 = critical damping)
-	lastUpdate  time.Time
-	threshold   float64 // Velocity threshold to consider "at rest"
+	id         int
+	state      *SliderState
+	target     float64
+	position   float64 // Simulated position, which the state follows
+	written    float64 // Last value written to the state
+	velocity   float64 // Units per second
+	mass       float64
+	stiffness  float64 // Spring stiffness (higher = faster)
+	damping    float64 // Damping coefficient (see WithDampingRatio)
+	lastUpdate time.Time
+	pending    time.Duration // Elapsed time not yet simulated
+	threshold  float64       // Velocity threshold to consider "at rest"
+	clock      Clock
+	paused     bool
+	pausedAt   time.Time
+	motionSetting
+
+	// Linear fade used instead of physics under MotionReduced
+	fading    bool
+	fadeFrom  float64
+	fadeTo    float64
+	fadeStart time.Time
 }
 
 // NewSpringAnimation creates a spring-based animation.
 func NewSpringAnimation(state *SliderState, target float64) *SpringAnimation {
 	return &SpringAnimation{
-		state:      state,
-		target:     target,
-		velocity:   0,
-		stiffness:  180,
-		damping:    12,
-		lastUpdate: time.Now(),
-		threshold:  0.01,
+		id:            NewAnimationID(),
+		state:         state,
+		target:        Clamp(target, state.Min(), state.Max()),
+		position:      state.Value(),
+		written:       state.Value(),
+		velocity:      0,
+		mass:          1,
+		stiffness:     180,
+		damping:       12,
+		lastUpdate:    RealClock.Now(),
+		threshold:     0.01,
+		clock:         RealClock,
+		motionSetting: motionFor(state),
+	}
+}
+
+// NewFlingAnimation creates a spring that continues a drag released with
+// the given velocity (see MouseState.ReleaseVelocity), coasting to a
+// resting point projected from that velocity.
+func NewFlingAnimation(state *SliderState, velocity float64) *SpringAnimation {
+	s := NewSpringAnimation(state, state.Value()).WithConfig(SpringGentle)
+	s.Fling(velocity)
+	return s
+}
+
+// WithClock sets the clock the spring reads time from.
+func (s *SpringAnimation) WithClock(c Clock) *SpringAnimation {
+	s.clock = clockOrReal(c)
+	s.lastUpdate = s.clock.Now()
+	return s
+}
+
+// WithMotion sets the spring's motion preference. Under MotionReduced the
+// spring fades linearly to its target; under MotionNone it jumps there.
+func (s *SpringAnimation) WithMotion(p MotionPreference) *SpringAnimation {
+	s.motion = p
+	return s
+}
+
+// WithConfig sets mass, stiffness and damping ratio from a preset.
+func (s *SpringAnimation) WithConfig(cfg SpringConfig) *SpringAnimation {
+	if cfg.Mass > 0 {
+		s.mass = cfg.Mass
 	}
+	s.stiffness = cfg.Stiffness
+	return s.WithDampingRatio(cfg.DampingRatio)
 }
 
 // WithStiffness sets the spring stiffness.
@@ -394,54 +1106,246 @@ func (s *SpringAnimation) WithStiffness(stiffness float64) *SpringAnimation {
 	return s
 }
 
-// WithDamping sets the damping ratio.
+// WithDamping sets the damping coefficient directly.
+// WithDampingRatio is usually easier to reason about.
 func (s *SpringAnimation) WithDamping(damping float64) *SpringAnimation {
 	s.damping = damping
 	return s
 }
 
-// SetTarget changes the animation target.
+// WithDampingRatio sets the damping relative to the current mass and
+// stiffness: 1 is critical damping, below 1 oscillates, above 1 creeps.
+func (s *SpringAnimation) WithDampingRatio(ratio float64) *SpringAnimation {
+	s.damping = ratio * 2 * math.Sqrt(math.Max(s.stiffness*s.mass, 0))
+	return s
+}
+
+// WithMass sets the spring's mass. Non-positive values are ignored.
+func (s *SpringAnimation) WithMass(mass float64) *SpringAnimation {
+	if mass > 0 {
+		s.mass = mass
+	}
+	return s
+}
+
+// WithVelocity sets the initial velocity in value units per second.
+func (s *SpringAnimation) WithVelocity(velocity float64) *SpringAnimation {
+	s.velocity = velocity
+	return s
+}
+
+// DampingRatio returns the spring's damping ratio.
+func (s *SpringAnimation) DampingRatio() float64 {
+	if s.stiffness <= 0 || s.mass <= 0 {
+		return 0
+	}
+	return s.damping / (2 * math.Sqrt(s.stiffness*s.mass))
+}
+
+// Velocity returns the current velocity in value units per second.
+func (s *SpringAnimation) Velocity() float64 {
+	return s.velocity
+}
+
+// SetTarget changes the animation target, keeping the current velocity.
+// Targets outside the state's bounds are clamped.
 func (s *SpringAnimation) SetTarget(target float64) {
-	s.target = target
+	s.target = Clamp(target, s.state.Min(), s.state.Max())
+}
+
+// Fling hands over a release velocity (value units per second) and
+// retargets the spring to where that motion would come to rest, snapped
+// to the state's step.
+func (s *SpringAnimation) Fling(velocity float64) {
+	s.velocity = velocity
+
+	state := s.state
+	target := Clamp(state.Value()+velocity*flingProjection, state.Min(), state.Max())
+	if step := state.Step(); step > 0 {
+		target = state.Min() + math.Round((target-state.Min())/step)*step
+	}
+	s.SetTarget(target)
 }
 
 // Update advances the spring simulation. Returns true if at rest.
 func (s *SpringAnimation) Update() bool {
-	now := time.Now()
-	dt := now.Sub(s.lastUpdate).Seconds()
-	s.lastUpdate = now
+	if s.paused {
+		return false
+	}
 
-	// Clamp dt to avoid instability
-	if dt > 0.1 {
-		dt = 0.1
+	switch s.effectiveMotion() {
+	case MotionNone:
+		return s.settle()
+	case MotionReduced:
+		return s.fade()
 	}
+	s.fading = false
 
-	current := s.state.Value()
-	displacement := current - s.target
+	now := s.clock.Now()
+	s.pending += min(now.Sub(s.lastUpdate), springMaxCatchUp)
+	s.lastUpdate = now
 
-	// Spring force: F = -kx - cv
-	springForce := -s.stiffness * displacement
-	dampingForce := -s.damping * s.velocity
-	acceleration := springForce + dampingForce
+	// Follow changes made to the state by anything else (keyboard, mouse)
+	// while keeping the spring's momentum.
+	if s.state.Value() != s.written {
+		s.position = s.state.Value()
+	}
 
-	// Update velocity and position
-	s.velocity += acceleration * dt
-	newValue := current + s.velocity*dt
-	s.state.SetValue(newValue)
+	for s.pending >= springTimestep {
+		s.step(springTimestep.Seconds())
+		s.pending -= springTimestep
+	}
 
 	// Check if at rest
-	if math.Abs(s.velocity) < s.threshold && math.Abs(displacement) < s.threshold {
-		s.state.SetValue(s.target)
+	if math.Abs(s.velocity) < s.threshold && math.Abs(s.position-s.target) < s.threshold {
+		s.position = s.target
 		s.velocity = 0
-		return true
+		s.pending = 0
+	}
+
+	s.state.SetValue(s.position)
+	s.written = s.state.Value()
+
+	return s.position == s.target && s.velocity == 0
+}
+
+// settle puts the spring at rest on its target. Returns true.
+func (s *SpringAnimation) settle() bool {
+	s.position = s.target
+	s.velocity = 0
+	s.pending = 0
+	s.fading = false
+	s.state.SetValue(s.target)
+	s.written = s.state.Value()
+	return true
+}
+
+// fade moves linearly toward the target over ReducedMotionFade, restarting
+// whenever the target changes. Returns true once it arrives.
+func (s *SpringAnimation) fade() bool {
+	now := s.clock.Now()
+	s.lastUpdate = now
+
+	if !s.fading || s.fadeTo != s.target {
+		s.fading = true
+		s.fadeFrom = s.state.Value()
+		s.fadeTo = s.target
+		s.fadeStart = now
+	}
+
+	progress := float64(now.Sub(s.fadeStart)) / float64(ReducedMotionFade)
+	if progress >= 1 {
+		return s.settle()
 	}
 
+	s.position = Lerp(s.fadeFrom, s.target, progress)
+	s.velocity = 0
+	s.state.SetValue(s.position)
+	s.written = s.state.Value()
 	return false
 }
 
+// step advances the simulation by dt seconds using the exact solution of
+// the damped harmonic oscillator m*x'' + c*x' + k*x = 0, then stops the
+// position at the state's bounds.
+func (s *SpringAnimation) step(dt float64) {
+	x0 := s.position - s.target
+	v0 := s.velocity
+	m, k, c := s.mass, s.stiffness, s.damping
+
+	var x, v float64
+	switch {
+	case k <= 0:
+		// No spring force: coast under damping alone.
+		decay := math.Exp(-c / m * dt)
+		x = x0 + v0*dt
+		v = v0 * decay
+
+	default:
+		w0 := math.Sqrt(k / m)
+		zeta := c / (2 * math.Sqrt(k*m))
+
+		switch {
+		case math.Abs(zeta-1) < 1e-6:
+			// Critically damped
+			decay := math.Exp(-w0 * dt)
+			b := v0 + w0*x0
+			x = decay * (x0 + b*dt)
+			v = decay * (v0 - w0*b*dt)
+
+		case zeta < 1:
+			// Under-damped: decaying oscillation
+			a := zeta * w0
+			wd := w0 * math.Sqrt(1-zeta*zeta)
+			b := (v0 + a*x0) / wd
+			decay := math.Exp(-a * dt)
+			sin, cos := math.Sincos(wd * dt)
+			x = decay * (x0*cos + b*sin)
+			v = decay * ((b*wd-a*x0)*cos - (x0*wd+a*b)*sin)
+
+		default:
+			// Over-damped: sum of two decaying exponentials
+			root := math.Sqrt(zeta*zeta - 1)
+			r1 := -w0 * (zeta - root)
+			r2 := -w0 * (zeta + root)
+			c1 := (v0 - r2*x0) / (r1 - r2)
+			c2 := x0 - c1
+			e1, e2 := math.Exp(r1*dt), math.Exp(r2*dt)
+			x = c1*e1 + c2*e2
+			v = c1*r1*e1 + c2*r2*e2
+		}
+	}
+
+	s.position = s.target + x
+	s.velocity = v
+
+	// Stop at the bounds instead of pushing against them, so no hidden
+	// velocity builds up while the value is pinned.
+	if lo := s.state.Min(); s.position <= lo {
+		s.position = lo
+		s.velocity = math.Max(s.velocity, 0)
+	}
+	if hi := s.state.Max(); s.position >= hi {
+		s.position = hi
+		s.velocity = math.Min(s.velocity, 0)
+	}
+}
+
+// ID returns the animation's program-wide unique ID.
+func (s *SpringAnimation) ID() int {
+	return s.id
+}
+
+// State returns the slider state being animated.
+func (s *SpringAnimation) State() *SliderState {
+	return s.state
+}
+
+// Pause freezes the spring, keeping its velocity.
+func (s *SpringAnimation) Pause() {
+	if !s.paused {
+		s.paused = true
+		s.pausedAt = s.clock.Now()
+	}
+}
+
+// Resume continues a paused spring without counting the paused time.
+func (s *SpringAnimation) Resume() {
+	if s.paused {
+		s.paused = false
+		s.lastUpdate = s.clock.Now()
+		s.fadeStart = s.fadeStart.Add(s.lastUpdate.Sub(s.pausedAt))
+	}
+}
+
 // Tick returns a command for the next spring frame.
 func (s *SpringAnimation) Tick() tea.Cmd {
-	return tea.Tick(16*time.Millisecond, func(t time.Time) tea.Msg {
-		return AnimationTickMsg{}
-	})
+	return animatorTick(s.id, DefaultFPS)
+}
+
+// HandleTick advances the spring if msg is a tick addressed to it and
+// returns the command for the next frame, or an AnimationCompleteMsg once
+// it is at rest.
+func (s *SpringAnimation) HandleTick(msg tea.Msg) tea.Cmd {
+	return handleAnimatorTick(s, msg, DefaultFPS)
 }
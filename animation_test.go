@@ -74,12 +74,14 @@ func TestNewAnimation(t *testing.T) {
 
 func TestAnimationWithOptions(t *testing.T) {
 	state := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
 	completed := false
 
 	anim := NewAnimation(state, 100,
 		WithAnimDuration(500*time.Millisecond),
 		WithEasing(EaseInCubic),
 		WithOnComplete(func() { completed = true }),
+		WithAnimClock(clock),
 	)
 
 	if anim.duration != 500*time.Millisecond {
@@ -88,7 +90,7 @@ func TestAnimationWithOptions(t *testing.T) {
 
 	// Run animation to completion
 	for !anim.Update() {
-		time.Sleep(20 * time.Millisecond)
+		clock.Advance(20 * time.Millisecond)
 	}
 
 	if !completed {
@@ -101,20 +103,26 @@ func TestAnimationWithOptions(t *testing.T) {
 
 func TestAnimationUpdate(t *testing.T) {
 	state := NewState(WithValue(0), WithMax(100))
-	anim := NewAnimation(state, 100, WithAnimDuration(100*time.Millisecond))
+	clock := NewManualClock(time.Time{})
+	anim := NewAnimation(state, 100,
+		WithAnimDuration(100*time.Millisecond),
+		WithEasing(Linear),
+		WithAnimClock(clock),
+	)
 
 	// First update should not be complete
+	clock.Advance(25 * time.Millisecond)
 	if anim.Update() {
 		t.Error("Animation should not be complete immediately")
 	}
 
 	// Value should have changed
-	if state.Value() == 0 {
-		t.Error("Value should have changed after update")
+	if state.Value() != 25 {
+		t.Errorf("Expected value 25 after a quarter of the duration, got %f", state.Value())
 	}
 
 	// Wait for animation to complete
-	time.Sleep(150 * time.Millisecond)
+	clock.Advance(150 * time.Millisecond)
 
 	if !anim.Update() {
 		t.Error("Animation should be complete after duration")
@@ -127,13 +135,14 @@ func TestAnimationUpdate(t *testing.T) {
 
 func TestAnimationIsComplete(t *testing.T) {
 	state := NewState(WithValue(0), WithMax(100))
-	anim := NewAnimation(state, 100, WithAnimDuration(50*time.Millisecond))
+	clock := NewManualClock(time.Time{})
+	anim := NewAnimation(state, 100, WithAnimDuration(50*time.Millisecond), WithAnimClock(clock))
 
 	if anim.IsComplete() {
 		t.Error("Animation should not be complete immediately")
 	}
 
-	time.Sleep(100 * time.Millisecond)
+	clock.Advance(50 * time.Millisecond)
 
 	if !anim.IsComplete() {
 		t.Error("Animation should be complete after duration")
@@ -151,7 +160,8 @@ func TestAnimationTick(t *testing.T) {
 }
 
 func TestAnimationManager(t *testing.T) {
-	manager := NewAnimationManager()
+	clock := NewManualClock(time.Time{})
+	manager := NewAnimationManager(WithClock(clock))
 
 	if manager.IsRunning() {
 		t.Error("Manager should not be running initially")
@@ -180,7 +190,7 @@ func TestAnimationManager(t *testing.T) {
 
 	// Update until complete
 	for manager.Update() {
-		time.Sleep(20 * time.Millisecond)
+		clock.Advance(20 * time.Millisecond)
 	}
 
 	if manager.IsRunning() {
@@ -205,6 +215,29 @@ func TestAnimationManagerCancel(t *testing.T) {
 	}
 }
 
+func TestAnimationManager_CancelFromOnComplete(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	manager := NewAnimationManager(WithClock(clock))
+	other := NewState(WithValue(0), WithMax(100))
+
+	var otherID int
+	manager.Start(NewState(WithValue(0), WithMax(100)), 100,
+		WithAnimDuration(10*time.Millisecond),
+		WithOnComplete(func() { manager.Cancel(otherID) }),
+	)
+	otherID = manager.Start(other, 100, WithAnimDuration(time.Second), WithEasing(Linear))
+
+	// The first animation finishes and cancels the second in the same frame
+	clock.Advance(20 * time.Millisecond)
+	manager.Update()
+	if manager.Count() != 0 {
+		t.Errorf("Expected no animations left, got %d", manager.Count())
+	}
+	if other.Value() != 0 {
+		t.Errorf("Cancelled animation should not advance, got %f", other.Value())
+	}
+}
+
 func TestAnimationManagerCancelAll(t *testing.T) {
 	manager := NewAnimationManager()
 
@@ -238,6 +271,431 @@ func TestAnimationManagerTick(t *testing.T) {
 	}
 }
 
+func TestAnimationTick_CarriesID(t *testing.T) {
+	a := NewAnimation(NewState(), 100)
+	b := NewAnimation(NewState(), 100)
+
+	if a.ID() == 0 || a.ID() == b.ID() {
+		t.Fatalf("Expected unique non-zero IDs, got %d and %d", a.ID(), b.ID())
+	}
+
+	msg, ok := a.Tick()().(AnimationTickMsg)
+	if !ok {
+		t.Fatal("Tick should produce an AnimationTickMsg")
+	}
+	if msg.ID != a.ID() || msg.ManagerID != 0 {
+		t.Errorf("Expected tick for animation %d, got %+v", a.ID(), msg)
+	}
+
+	// Ticks for other animations are ignored
+	if cmd := b.HandleTick(msg); cmd != nil {
+		t.Error("HandleTick should ignore ticks for other animations")
+	}
+	if cmd := a.HandleTick(msg); cmd == nil {
+		t.Error("HandleTick should request the next frame while running")
+	}
+}
+
+func TestAnimationManager_SingleOutstandingTick(t *testing.T) {
+	manager := NewAnimationManager()
+	manager.Start(NewState(), 100, WithAnimDuration(time.Second))
+	manager.Start(NewState(), 50, WithAnimDuration(time.Second))
+
+	cmd := manager.Tick()
+	if cmd == nil {
+		t.Fatal("Tick should return a command with active animations")
+	}
+	if manager.Tick() != nil {
+		t.Error("Tick should return nil while a tick is outstanding")
+	}
+
+	msg := cmd().(AnimationTickMsg)
+	if msg.ManagerID != manager.ID() {
+		t.Errorf("Expected ManagerID %d, got %d", manager.ID(), msg.ManagerID)
+	}
+
+	if manager.HandleTick(msg) == nil {
+		t.Error("HandleTick should request the next frame while running")
+	}
+	if manager.Tick() != nil {
+		t.Error("HandleTick should leave exactly one tick outstanding")
+	}
+}
+
+func TestAnimationManager_HandleTickRouting(t *testing.T) {
+	m1 := NewAnimationManager()
+	m2 := NewAnimationManager()
+	if m1.ID() == m2.ID() {
+		t.Fatal("Manager IDs should be unique")
+	}
+
+	state := NewState(WithValue(0), WithMax(100))
+	m1.Start(state, 100, WithAnimDuration(time.Second))
+	m1.Tick()
+
+	foreign := AnimationTickMsg{ManagerID: m2.ID()}
+	if m1.HandleTick(foreign) != nil {
+		t.Error("HandleTick should ignore ticks for other managers")
+	}
+	if state.Value() != 0 {
+		t.Error("Foreign ticks should not advance animations")
+	}
+	if m1.HandleTick("not a tick") != nil {
+		t.Error("HandleTick should ignore other messages")
+	}
+}
+
+func TestAnimationManager_StopsWhenIdle(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	manager := NewAnimationManager(WithClock(clock))
+	state := NewState(WithValue(0), WithMax(100))
+	manager.Start(state, 100, WithAnimDuration(time.Millisecond))
+	manager.Tick()
+
+	clock.Advance(time.Millisecond)
+	cmd := manager.HandleTick(AnimationTickMsg{ManagerID: manager.ID()})
+	if cmd == nil {
+		t.Fatal("HandleTick should report the completed animation")
+	}
+	if _, ok := cmd().(AnimationCompleteMsg); !ok {
+		t.Error("HandleTick should only deliver a completion once idle")
+	}
+	if state.Value() != 100 {
+		t.Errorf("Expected final value 100, got %f", state.Value())
+	}
+
+	// Starting again resumes ticking
+	manager.Start(state, 0)
+	if manager.Tick() == nil {
+		t.Error("Tick should resume after a new animation starts")
+	}
+}
+
+func TestAnimationManager_FPS(t *testing.T) {
+	manager := NewAnimationManager()
+	if manager.FPS() != DefaultFPS {
+		t.Errorf("Expected default FPS %d, got %d", DefaultFPS, manager.FPS())
+	}
+
+	manager = NewAnimationManager(WithFPS(30))
+	if manager.FPS() != 30 {
+		t.Errorf("Expected FPS 30, got %d", manager.FPS())
+	}
+
+	manager.SetFPS(0)
+	if manager.FPS() != 30 {
+		t.Error("SetFPS should ignore non-positive values")
+	}
+
+	if frameInterval(30) != time.Second/30 {
+		t.Errorf("Unexpected frame interval %v", frameInterval(30))
+	}
+}
+
+func TestAnimationManager_CompleteMsg(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	manager := NewAnimationManager(WithClock(clock))
+	id := manager.Start(NewState(), 100, WithAnimDuration(time.Millisecond))
+	manager.Tick()
+	clock.Advance(time.Millisecond)
+
+	msg, ok := manager.HandleTick(AnimationTickMsg{ManagerID: manager.ID()})().(AnimationCompleteMsg)
+	if !ok {
+		t.Fatal("Expected an AnimationCompleteMsg")
+	}
+	if msg.ID != id || msg.ManagerID != manager.ID() {
+		t.Errorf("Expected completion of %d from manager %d, got %+v", id, manager.ID(), msg)
+	}
+}
+
+func TestAnimationManager_AnimateToRetargets(t *testing.T) {
+	manager := NewAnimationManager()
+	state := NewState(WithValue(0), WithMax(100))
+
+	id, cmd := manager.AnimateTo(state, 100, WithAnimDuration(time.Second))
+	if cmd == nil {
+		t.Fatal("AnimateTo should return the first frame")
+	}
+
+	retargetID, cmd := manager.AnimateTo(state, 20)
+	if retargetID != id {
+		t.Errorf("Retargeting should keep ID %d, got %d", id, retargetID)
+	}
+	if cmd != nil {
+		t.Error("Retargeting should not request a second tick")
+	}
+	if manager.Count() != 1 {
+		t.Errorf("Expected 1 animation, got %d", manager.Count())
+	}
+
+	if !manager.Retarget(id, 40) {
+		t.Error("Retarget should find the running animation")
+	}
+	if manager.Retarget(-1, 40) {
+		t.Error("Retarget should report unknown IDs")
+	}
+
+	if !manager.CancelState(state) || manager.Count() != 0 {
+		t.Error("CancelState should stop the animation")
+	}
+	if manager.CancelState(state) {
+		t.Error("CancelState should report when nothing was running")
+	}
+}
+
+func TestAnimationManager_AnimateToPausedAndOptions(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	manager := NewAnimationManager(WithClock(clock))
+	state := NewState(WithValue(0), WithMax(100))
+
+	id, _ := manager.AnimateTo(state, 100, WithAnimDuration(100*time.Millisecond), WithEasing(Linear))
+	manager.Pause(id)
+	manager.Update() // The outstanding tick arrives and the clock stops
+
+	// Retargeting resumes the tween and keeps the settings not given
+	if _, cmd := manager.AnimateTo(state, 50); cmd == nil {
+		t.Error("Retargeting a paused tween should request a frame")
+	}
+	if manager.IsPaused(id) {
+		t.Error("Retargeting should resume a paused tween")
+	}
+	clock.Advance(50 * time.Millisecond)
+	manager.Update()
+	if state.Value() != 25 {
+		t.Errorf("Expected the original duration and easing, half-way at 25, got %f", state.Value())
+	}
+
+	// Options given override the tween's
+	manager.AnimateTo(state, 75, WithAnimDuration(200*time.Millisecond))
+	clock.Advance(100 * time.Millisecond)
+	manager.Update()
+	if state.Value() != 50 {
+		t.Errorf("Expected the new duration, half-way at 50, got %f", state.Value())
+	}
+}
+
+func TestAnimateTo_AdvancesOnUpdateLoop(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+
+	cmd := AnimateTo(state, 100, 20*time.Millisecond, Linear)
+	if cmd == nil {
+		t.Fatal("AnimateTo should return a command")
+	}
+
+	// Nothing moves until ticks are handled
+	time.Sleep(30 * time.Millisecond)
+	if state.Value() != 0 {
+		t.Errorf("State should not change off the Update loop, got %f", state.Value())
+	}
+
+	var complete AnimationCompleteMsg
+	for i := 0; cmd != nil && i < 100; i++ {
+		msg := cmd()
+		if c, ok := msg.(AnimationCompleteMsg); ok {
+			complete = c
+			break
+		}
+		cmd = HandleAnimateTo(msg)
+	}
+
+	if complete.ID == 0 {
+		t.Fatal("Expected an AnimationCompleteMsg with the animation ID")
+	}
+	if state.Value() != 100 {
+		t.Errorf("Expected final value 100, got %f", state.Value())
+	}
+}
+
+func TestAnimateTo_Cancel(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+
+	cmd := AnimateTo(state, 100, time.Second, Linear)
+	if !CancelAnimateTo(state) {
+		t.Error("CancelAnimateTo should stop the running animation")
+	}
+	if CancelAnimateTo(state) {
+		t.Error("CancelAnimateTo should report when nothing was running")
+	}
+
+	// The tick already in flight stops the clock
+	if HandleAnimateTo(cmd()) != nil {
+		t.Error("HandleAnimateTo should stop once nothing is running")
+	}
+	if state.Value() != 0 {
+		t.Errorf("Cancelled animation should leave the value alone, got %f", state.Value())
+	}
+}
+
+func TestAnimateToWith(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	manager := NewAnimationManager(WithClock(clock))
+	state := NewState(WithValue(0), WithMax(100))
+
+	cmd := AnimateToWith(manager, state, 100, 100*time.Millisecond, Linear)
+	if cmd == nil || manager.Count() != 1 {
+		t.Fatal("AnimateToWith should start the animation on the given manager")
+	}
+	if CancelAnimateTo(state) {
+		t.Error("CancelAnimateTo should not stop animations of other managers")
+	}
+
+	msg := cmd()
+	if HandleAnimateTo(msg) != nil {
+		t.Error("HandleAnimateTo should ignore ticks of other managers")
+	}
+	clock.Advance(50 * time.Millisecond)
+	manager.HandleTick(msg)
+	if state.Value() != 50 {
+		t.Errorf("Expected the manager's clock to drive the animation, got %f", state.Value())
+	}
+
+	// Without a manager it runs where AnimateTo does
+	other := NewState(WithValue(0), WithMax(100))
+	AnimateToWith(nil, other, 100, time.Second, Linear)
+	if !CancelAnimateTo(other) {
+		t.Error("A nil manager should use the AnimateTo manager")
+	}
+}
+
+// countdown is a custom frame-based Animator used in tests.
+type countdown struct {
+	id     int
+	state  *SliderState
+	frames int
+}
+
+func (c *countdown) ID() int             { return c.id }
+func (c *countdown) State() *SliderState { return c.state }
+func (c *countdown) Update() bool {
+	c.frames--
+	c.state.Increment()
+	return c.frames <= 0
+}
+
+var (
+	_ Animator = (*Animation)(nil)
+	_ Animator = (*PulseAnimation)(nil)
+	_ Animator = (*SpringAnimation)(nil)
+	_ Pauser   = (*Animation)(nil)
+	_ Pauser   = (*PulseAnimation)(nil)
+	_ Pauser   = (*SpringAnimation)(nil)
+)
+
+func TestAnimationManager_RunAnyAnimator(t *testing.T) {
+	manager := NewAnimationManager()
+
+	spring := NewSpringAnimation(NewState(WithValue(0), WithMax(100)), 100)
+	pulse := NewPulseAnimation(NewState(WithValue(50)), 10, 1, 0)
+	custom := &countdown{id: NewAnimationID(), state: NewState(WithValue(0)), frames: 3}
+
+	if id := manager.Run(spring); id != spring.ID() {
+		t.Errorf("Run should return the animator's ID %d, got %d", spring.ID(), id)
+	}
+	manager.Run(pulse)
+	manager.Run(custom)
+
+	if manager.Count() != 3 {
+		t.Fatalf("Expected 3 animations, got %d", manager.Count())
+	}
+	if a, ok := manager.Get(pulse.ID()); !ok || a != Animator(pulse) {
+		t.Error("Get should return the running animator")
+	}
+
+	for i := 0; i < 3; i++ {
+		manager.Update()
+	}
+	if _, ok := manager.Get(custom.ID()); ok {
+		t.Error("Custom animator should be removed once finished")
+	}
+	if custom.state.Value() != 3 {
+		t.Errorf("Expected custom animator to run 3 frames, got %f", custom.state.Value())
+	}
+}
+
+func TestAnimationManager_ReplacesSameState(t *testing.T) {
+	manager := NewAnimationManager()
+	state := NewState(WithValue(0), WithMax(100))
+
+	springID := manager.Run(NewSpringAnimation(state, 100))
+	tweenID := manager.Start(state, 50)
+
+	if manager.Count() != 1 {
+		t.Fatalf("Expected 1 animation per state, got %d", manager.Count())
+	}
+	if _, ok := manager.Get(springID); ok {
+		t.Error("Starting a tween should replace the spring on the same state")
+	}
+
+	// AnimateTo replaces non-tween animators but retargets tweens
+	if id, _ := manager.AnimateTo(state, 80); id != tweenID {
+		t.Errorf("AnimateTo should retarget tween %d, got %d", tweenID, id)
+	}
+	pulseID := manager.Run(NewPulseAnimation(state, 5, 1, 0))
+	if id, _ := manager.AnimateTo(state, 20); id == pulseID || manager.Count() != 1 {
+		t.Error("AnimateTo should replace a pulse with a new tween")
+	}
+}
+
+func TestAnimationManager_PauseResume(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	manager := NewAnimationManager(WithClock(clock))
+	state := NewState(WithValue(0), WithMax(100))
+	id := manager.Start(state, 100, WithAnimDuration(50*time.Millisecond), WithEasing(Linear))
+
+	if !manager.Pause(id) || !manager.IsPaused(id) {
+		t.Fatal("Pause should pause the running animation")
+	}
+	if manager.Pause(-1) {
+		t.Error("Pause should report unknown IDs")
+	}
+	if manager.IsRunning() || manager.Tick() != nil {
+		t.Error("A manager with only paused animations should be idle")
+	}
+	if manager.Count() != 1 {
+		t.Error("Paused animations should still be counted")
+	}
+
+	clock.Advance(60 * time.Millisecond)
+	manager.Update()
+	if state.Value() != 0 {
+		t.Errorf("Paused animation should not advance, got %f", state.Value())
+	}
+
+	if manager.Resume(id) == nil {
+		t.Error("Resume should restart the frame clock")
+	}
+	if manager.IsPaused(id) {
+		t.Error("Resume should clear the paused flag")
+	}
+	if manager.Resume(id) != nil {
+		t.Error("Resuming a running animation should do nothing")
+	}
+
+	// Paused time doesn't count toward the duration
+	clock.Advance(25 * time.Millisecond)
+	manager.Update()
+	if state.Value() != 50 {
+		t.Errorf("Resumed animation should be half-way, got %f", state.Value())
+	}
+}
+
+func TestAnimation_PauseResume(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	anim := NewAnimation(state, 100, WithAnimDuration(50*time.Millisecond), WithAnimClock(clock))
+
+	anim.Pause()
+	clock.Advance(60 * time.Millisecond)
+	if anim.Update() || anim.IsComplete() {
+		t.Error("Paused animation should not complete")
+	}
+
+	anim.Resume()
+	if anim.IsComplete() {
+		t.Error("Resumed animation should continue where it left off")
+	}
+}
+
 func TestLerp(t *testing.T) {
 	tests := []struct {
 		start, end, t, expected float64
@@ -320,19 +778,20 @@ func TestSmootherStep(t *testing.T) {
 
 func TestPulseAnimation(t *testing.T) {
 	state := NewState(WithValue(50), WithMin(0), WithMax(100))
-	pulse := NewPulseAnimation(state, 10, 2, 100*time.Millisecond)
+	clock := NewManualClock(time.Time{})
+	pulse := NewPulseAnimation(state, 10, 2, 200*time.Millisecond).WithClock(clock)
 
-	// Run a few frames
-	for i := 0; i < 5; i++ {
-		pulse.Update()
-		time.Sleep(20 * time.Millisecond)
+	// A quarter of a 2Hz cycle reaches the peak
+	clock.Advance(125 * time.Millisecond)
+	if pulse.Update() {
+		t.Error("Pulse should not complete before its duration")
+	}
+	if math.Abs(state.Value()-60) > 0.001 {
+		t.Errorf("Expected peak value 60, got %f", state.Value())
 	}
-
-	// Value should have changed from base
-	// (not checking exact values due to timing)
 
 	// Wait for completion
-	time.Sleep(100 * time.Millisecond)
+	clock.Advance(100 * time.Millisecond)
 	if !pulse.Update() {
 		t.Error("Pulse should complete after duration")
 	}
@@ -345,11 +804,12 @@ func TestPulseAnimation(t *testing.T) {
 
 func TestPulseAnimationInfinite(t *testing.T) {
 	state := NewState(WithValue(50))
-	pulse := NewPulseAnimation(state, 10, 1, 0) // 0 duration = infinite
+	clock := NewManualClock(time.Time{})
+	pulse := NewPulseAnimation(state, 10, 1, 0).WithClock(clock) // 0 duration = infinite
 
 	// Should not complete
 	pulse.Update()
-	time.Sleep(50 * time.Millisecond)
+	clock.Advance(time.Hour)
 
 	if pulse.Update() {
 		t.Error("Infinite pulse should not complete")
@@ -368,14 +828,15 @@ func TestPulseAnimationTick(t *testing.T) {
 
 func TestSpringAnimation(t *testing.T) {
 	state := NewState(WithValue(0), WithMin(0), WithMax(100))
-	spring := NewSpringAnimation(state, 100)
+	clock := NewManualClock(time.Time{})
+	spring := NewSpringAnimation(state, 100).WithClock(clock)
 
 	// Run animation for a bit
 	for i := 0; i < 50; i++ {
 		if spring.Update() {
 			break
 		}
-		time.Sleep(16 * time.Millisecond)
+		clock.Advance(16 * time.Millisecond)
 	}
 
 	// Value should have moved toward target
@@ -386,14 +847,15 @@ func TestSpringAnimation(t *testing.T) {
 
 func TestSpringAnimationSetTarget(t *testing.T) {
 	state := NewState(WithValue(50))
-	spring := NewSpringAnimation(state, 100)
+	clock := NewManualClock(time.Time{})
+	spring := NewSpringAnimation(state, 100).WithClock(clock)
 
 	spring.SetTarget(0)
 
 	// Run a few frames
 	for i := 0; i < 10; i++ {
 		spring.Update()
-		time.Sleep(16 * time.Millisecond)
+		clock.Advance(16 * time.Millisecond)
 	}
 
 	// Should be moving toward new target (0)
@@ -416,6 +878,168 @@ func TestSpringAnimationWithOptions(t *testing.T) {
 	}
 }
 
+// runSpring advances a spring for total time in frames of the given size.
+func runSpring(s *SpringAnimation, clock *ManualClock, total, frame time.Duration) {
+	for elapsed := time.Duration(0); elapsed < total; elapsed += frame {
+		clock.Advance(frame)
+		s.Update()
+	}
+}
+
+func TestSpringAnimation_FrameRateIndependent(t *testing.T) {
+	var values []float64
+	for _, frame := range []time.Duration{time.Millisecond, 10 * time.Millisecond, 100 * time.Millisecond} {
+		state := NewState(WithValue(0), WithMax(100))
+		clock := NewManualClock(time.Time{})
+		spring := NewSpringAnimation(state, 80).WithClock(clock).WithConfig(SpringWobbly)
+
+		runSpring(spring, clock, 300*time.Millisecond, frame)
+		values = append(values, state.Value())
+	}
+
+	for i := 1; i < len(values); i++ {
+		if math.Abs(values[i]-values[0]) > 1e-9 {
+			t.Errorf("Spring depends on frame rate: %v", values)
+		}
+	}
+}
+
+func TestSpringAnimation_StiffSpringIsStable(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	spring := NewSpringAnimation(state, 50).WithClock(clock).
+		WithStiffness(100000).
+		WithDampingRatio(0.05)
+
+	for i := 0; i < 600; i++ {
+		clock.Advance(50 * time.Millisecond)
+		spring.Update()
+		if v := state.Value(); math.IsNaN(v) || math.Abs(v-50) > 50 {
+			t.Fatalf("Stiff spring diverged at frame %d: %f", i, v)
+		}
+	}
+}
+
+func TestSpringAnimation_Presets(t *testing.T) {
+	presets := map[string]SpringConfig{
+		"gentle": SpringGentle,
+		"wobbly": SpringWobbly,
+		"stiff":  SpringStiff,
+	}
+
+	for name, cfg := range presets {
+		t.Run(name, func(t *testing.T) {
+			state := NewState(WithValue(0), WithMax(200))
+			clock := NewManualClock(time.Time{})
+			spring := NewSpringAnimation(state, 100).WithClock(clock).WithConfig(cfg)
+
+			if math.Abs(spring.DampingRatio()-cfg.DampingRatio) > 1e-9 {
+				t.Errorf("Expected damping ratio %f, got %f", cfg.DampingRatio, spring.DampingRatio())
+			}
+
+			for i := 0; i < 1000 && !spring.Update(); i++ {
+				clock.Advance(16 * time.Millisecond)
+			}
+			if state.Value() != 100 {
+				t.Errorf("Expected spring to settle at 100, got %f", state.Value())
+			}
+		})
+	}
+}
+
+func TestSpringAnimation_DampingRegimes(t *testing.T) {
+	for _, ratio := range []float64{1, 2} {
+		state := NewState(WithValue(0), WithMax(200))
+		clock := NewManualClock(time.Time{})
+		spring := NewSpringAnimation(state, 100).WithClock(clock).
+			WithMass(2).
+			WithStiffness(300).
+			WithDampingRatio(ratio)
+
+		for i := 0; i < 500; i++ {
+			clock.Advance(10 * time.Millisecond)
+			spring.Update()
+			if state.Value() > 100+1e-9 {
+				t.Fatalf("Damping ratio %f should not overshoot, got %f", ratio, state.Value())
+			}
+		}
+		if math.Abs(state.Value()-100) > 0.01 {
+			t.Errorf("Damping ratio %f should settle at 100, got %f", ratio, state.Value())
+		}
+	}
+}
+
+func TestSpringAnimation_RespectsBounds(t *testing.T) {
+	// A wobbly spring to 95 would overshoot well past the maximum
+	state := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	spring := NewSpringAnimation(state, 95).WithClock(clock).WithConfig(SpringWobbly)
+
+	peak := 0.0
+	for i := 0; i < 500 && !spring.Update(); i++ {
+		peak = math.Max(peak, state.Value())
+		if spring.position > 100 {
+			t.Fatalf("Simulated position left the range: %f", spring.position)
+		}
+		clock.Advance(16 * time.Millisecond)
+	}
+
+	// The spring stops at the wall and comes straight back, rather than
+	// lingering there while hidden velocity drains away.
+	if peak < 99 {
+		t.Errorf("Expected the spring to reach the bound, peaked at %f", peak)
+	}
+	if state.Value() != 95 {
+		t.Errorf("Expected spring to settle at 95, got %f", state.Value())
+	}
+
+	// Targets outside the range are clamped
+	spring.SetTarget(150)
+	if spring.target != 100 {
+		t.Errorf("Expected target clamped to 100, got %f", spring.target)
+	}
+}
+
+func TestSpringAnimation_FollowsExternalChanges(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	spring := NewSpringAnimation(state, 100).WithClock(clock).WithConfig(SpringStiff)
+
+	clock.Advance(16 * time.Millisecond)
+	spring.Update()
+
+	state.SetValue(90)
+	clock.Advance(16 * time.Millisecond)
+	spring.Update()
+	if state.Value() < 90 {
+		t.Errorf("Spring should continue from the externally set value, got %f", state.Value())
+	}
+}
+
+func TestFlingAnimation(t *testing.T) {
+	state := NewState(WithValue(50), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	fling := NewFlingAnimation(state, 100).WithClock(clock)
+
+	if fling.target != 70 {
+		t.Errorf("Expected projected target 70, got %f", fling.target)
+	}
+	if fling.Velocity() != 100 {
+		t.Errorf("Expected handed-over velocity 100, got %f", fling.Velocity())
+	}
+
+	clock.Advance(16 * time.Millisecond)
+	fling.Update()
+	if state.Value() <= 50 {
+		t.Errorf("Fling should keep moving in the release direction, got %f", state.Value())
+	}
+
+	// Projections are clamped to the range
+	if f := NewFlingAnimation(NewState(WithValue(90), WithMax(100)), 1000); f.target != 100 {
+		t.Errorf("Expected fling target clamped to 100, got %f", f.target)
+	}
+}
+
 func TestSpringAnimationTick(t *testing.T) {
 	state := NewState()
 	spring := NewSpringAnimation(state, 100)
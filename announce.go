@@ -0,0 +1,373 @@
+package tuslide
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Politeness tells a screen reader how urgently to speak an announcement,
+// after ARIA live regions.
+type Politeness int
+
+const (
+	// Polite announcements wait until the screen reader is idle. Value
+	// changes are polite.
+	Polite Politeness = iota
+	// Assertive announcements interrupt whatever is being spoken. Reaching
+	// a boundary is assertive.
+	Assertive
+)
+
+// String returns "polite" or "assertive".
+func (p Politeness) String() string {
+	if p == Assertive {
+		return "assertive"
+	}
+	return "polite"
+}
+
+// Announcer delivers announcements to a screen reader, status line or log.
+// Implementations must be safe for concurrent use: a Debouncer may deliver
+// from a tick.
+type Announcer interface {
+	Announce(msg string, politeness Politeness)
+}
+
+// AnnouncerFunc adapts a plain callback to the Announcer interface. The
+// politeness is dropped.
+type AnnouncerFunc func(msg string)
+
+// Announce calls f(msg).
+func (f AnnouncerFunc) Announce(msg string, _ Politeness) {
+	f(msg)
+}
+
+// Announcement is a message with its politeness.
+type Announcement struct {
+	Message    string
+	Politeness Politeness
+}
+
+// WriterAnnouncer writes each announcement on its own line, for screen
+// readers that follow a log file or stderr.
+type WriterAnnouncer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterAnnouncer creates an announcer writing to w, e.g. an open file.
+func NewWriterAnnouncer(w io.Writer) *WriterAnnouncer {
+	return &WriterAnnouncer{w: w}
+}
+
+// NewStderrAnnouncer creates an announcer writing to stderr, which stays
+// readable while the UI owns stdout.
+func NewStderrAnnouncer() *WriterAnnouncer {
+	return NewWriterAnnouncer(os.Stderr)
+}
+
+// Announce writes msg followed by a newline. Write errors are ignored.
+func (w *WriterAnnouncer) Announce(msg string, _ Politeness) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	fmt.Fprintln(w.w, msg)
+}
+
+// BellAnnouncer rings the terminal bell for assertive announcements and
+// passes every announcement on to the next announcer, if any.
+type BellAnnouncer struct {
+	mu   sync.Mutex
+	w    io.Writer
+	next Announcer
+}
+
+// NewBellAnnouncer creates a bell writing to w (stderr if nil) that
+// forwards to next (may be nil).
+func NewBellAnnouncer(w io.Writer, next Announcer) *BellAnnouncer {
+	if w == nil {
+		w = os.Stderr
+	}
+	return &BellAnnouncer{w: w, next: next}
+}
+
+// Announce rings the bell if the announcement is assertive.
+func (b *BellAnnouncer) Announce(msg string, politeness Politeness) {
+	if politeness == Assertive {
+		b.mu.Lock()
+		io.WriteString(b.w, "\a")
+		b.mu.Unlock()
+	}
+	if b.next != nil {
+		b.next.Announce(msg, politeness)
+	}
+}
+
+// QueueAnnouncer records announcements, for tests and for UIs that show
+// them in a status line.
+type QueueAnnouncer struct {
+	mu    sync.Mutex
+	queue []Announcement
+}
+
+// NewQueueAnnouncer creates an empty queue.
+func NewQueueAnnouncer() *QueueAnnouncer {
+	return &QueueAnnouncer{}
+}
+
+// Announce appends the announcement to the queue.
+func (q *QueueAnnouncer) Announce(msg string, politeness Politeness) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue = append(q.queue, Announcement{Message: msg, Politeness: politeness})
+}
+
+// Announcements returns a copy of the queued announcements, oldest first.
+func (q *QueueAnnouncer) Announcements() []Announcement {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]Announcement(nil), q.queue...)
+}
+
+// Messages returns the queued messages, oldest first.
+func (q *QueueAnnouncer) Messages() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	msgs := make([]string, len(q.queue))
+	for i, a := range q.queue {
+		msgs[i] = a.Message
+	}
+	return msgs
+}
+
+// Pop removes and returns the oldest announcement. Returns false if the
+// queue is empty.
+func (q *QueueAnnouncer) Pop() (Announcement, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.queue) == 0 {
+		return Announcement{}, false
+	}
+	a := q.queue[0]
+	q.queue = q.queue[1:]
+	return a, true
+}
+
+// Len returns the number of queued announcements.
+func (q *QueueAnnouncer) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.queue)
+}
+
+// Clear empties the queue.
+func (q *QueueAnnouncer) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue = nil
+}
+
+// multiAnnouncer sends every announcement to several announcers.
+type multiAnnouncer []Announcer
+
+// MultiAnnouncer returns an announcer that delivers to all of announcers
+// in order, e.g. a file log and the terminal bell.
+func MultiAnnouncer(announcers ...Announcer) Announcer {
+	return multiAnnouncer(announcers)
+}
+
+// Announce delivers to each announcer.
+func (m multiAnnouncer) Announce(msg string, politeness Politeness) {
+	for _, a := range m {
+		if a != nil {
+			a.Announce(msg, politeness)
+		}
+	}
+}
+
+// DefaultAnnounceDelay is how long a Debouncer waits for value changes to
+// stop before announcing.
+const DefaultAnnounceDelay = 300 * time.Millisecond
+
+// AnnounceFlushMsg is sent when a Debouncer's delay may have run out.
+type AnnounceFlushMsg struct {
+	ID int // Debouncer that requested the flush
+}
+
+var lastDebouncerID atomic.Int64
+
+// Debouncer coalesces rapid polite announcements, so holding an arrow key
+// announces the value the slider lands on rather than every step. A polite
+// announcement is held until no other has arrived for the delay and is
+// replaced by newer ones. Assertive announcements are delivered at once
+// and discard the held one.
+//
+// Nothing is delivered until Flush runs: call it periodically, or in a
+// Bubble Tea program return Tick after announcing and pass messages to
+// HandleTick.
+type Debouncer struct {
+	id      int
+	next    Announcer
+	delay   time.Duration
+	maxWait time.Duration
+	clock   Clock
+
+	mu        sync.Mutex
+	pending   *Announcement
+	first     time.Time // When the held announcement's burst began
+	last      time.Time // When it was last replaced
+	scheduled bool
+}
+
+// NewDebouncer creates a debouncer delivering to next (may be nil) after
+// DefaultAnnounceDelay.
+func NewDebouncer(next Announcer) *Debouncer {
+	return &Debouncer{
+		id:    int(lastDebouncerID.Add(1)),
+		next:  next,
+		delay: DefaultAnnounceDelay,
+		clock: RealClock,
+	}
+}
+
+// WithDelay sets how long changes must pause before the latest one is
+// announced.
+func (d *Debouncer) WithDelay(delay time.Duration) *Debouncer {
+	d.delay = max(delay, 0)
+	return d
+}
+
+// WithMaxWait sets the longest an announcement is held while changes keep
+// arriving, so a long key press still reports progress. Zero (the
+// default) waits for a pause however long it takes.
+func (d *Debouncer) WithMaxWait(maxWait time.Duration) *Debouncer {
+	d.maxWait = max(maxWait, 0)
+	return d
+}
+
+// WithClock sets the clock used to time the delay.
+func (d *Debouncer) WithClock(c Clock) *Debouncer {
+	if c == nil {
+		c = RealClock
+	}
+	d.clock = c
+	return d
+}
+
+// ID returns the debouncer's program-wide unique ID.
+func (d *Debouncer) ID() int {
+	return d.id
+}
+
+// Announce holds polite announcements and delivers assertive ones.
+func (d *Debouncer) Announce(msg string, politeness Politeness) {
+	if politeness == Assertive {
+		d.mu.Lock()
+		d.pending = nil
+		d.mu.Unlock()
+		d.deliver(msg, politeness)
+		return
+	}
+
+	now := d.clock.Now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.pending == nil {
+		d.first = now
+	}
+	d.pending = &Announcement{Message: msg, Politeness: politeness}
+	d.last = now
+}
+
+// Pending reports whether an announcement is being held.
+func (d *Debouncer) Pending() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.pending != nil
+}
+
+// Flush delivers the held announcement if its delay has run out. Returns
+// true if it delivered.
+func (d *Debouncer) Flush() bool {
+	d.mu.Lock()
+	a := d.pending
+	if a == nil || d.wait(d.clock.Now()) > 0 {
+		d.mu.Unlock()
+		return false
+	}
+	d.pending = nil
+	d.mu.Unlock()
+
+	d.deliver(a.Message, a.Politeness)
+	return true
+}
+
+// Drain delivers the held announcement immediately, e.g. when the slider
+// loses focus.
+func (d *Debouncer) Drain() {
+	d.mu.Lock()
+	a := d.pending
+	d.pending = nil
+	d.mu.Unlock()
+
+	if a != nil {
+		d.deliver(a.Message, a.Politeness)
+	}
+}
+
+// deliver forwards an announcement to next, if there is one.
+func (d *Debouncer) deliver(msg string, politeness Politeness) {
+	if d.next != nil {
+		d.next.Announce(msg, politeness)
+	}
+}
+
+// wait returns how long until the held announcement is due. Callers hold
+// d.mu.
+func (d *Debouncer) wait(now time.Time) time.Duration {
+	due := d.last.Add(d.delay)
+	if d.maxWait > 0 {
+		if limit := d.first.Add(d.maxWait); limit.Before(due) {
+			due = limit
+		}
+	}
+	return due.Sub(now)
+}
+
+// Tick returns a command delivering an AnnounceFlushMsg when the held
+// announcement is due. Returns nil if nothing is held or a flush is
+// already scheduled.
+func (d *Debouncer) Tick() tea.Cmd {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.pending == nil || d.scheduled {
+		return nil
+	}
+	d.scheduled = true
+
+	id := d.id
+	return tea.Tick(max(d.wait(d.clock.Now()), 0), func(time.Time) tea.Msg {
+		return AnnounceFlushMsg{ID: id}
+	})
+}
+
+// HandleTick flushes if msg is addressed to this debouncer and returns the
+// command for the next flush while an announcement is still held.
+func (d *Debouncer) HandleTick(msg tea.Msg) tea.Cmd {
+	flush, ok := msg.(AnnounceFlushMsg)
+	if !ok || flush.ID != d.id {
+		return nil
+	}
+
+	d.mu.Lock()
+	d.scheduled = false
+	d.mu.Unlock()
+
+	d.Flush()
+	return d.Tick()
+}
@@ -0,0 +1,158 @@
+package tuslide
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQueueAnnouncer(t *testing.T) {
+	q := NewQueueAnnouncer()
+	q.Announce("one", Polite)
+	q.Announce("two", Assertive)
+
+	if got := q.Messages(); !reflect.DeepEqual(got, []string{"one", "two"}) {
+		t.Errorf("Expected both messages in order, got %v", got)
+	}
+
+	a, ok := q.Pop()
+	if !ok || a != (Announcement{"one", Polite}) {
+		t.Errorf("Expected to pop the oldest, got %v", a)
+	}
+	if q.Len() != 1 {
+		t.Errorf("Expected 1 left, got %d", q.Len())
+	}
+
+	q.Clear()
+	if _, ok := q.Pop(); ok {
+		t.Error("Pop on an empty queue should fail")
+	}
+}
+
+func TestWriterAndBellAnnouncers(t *testing.T) {
+	var log, bell bytes.Buffer
+	a := NewBellAnnouncer(&bell, NewWriterAnnouncer(&log))
+
+	a.Announce("50, 50 percent", Polite)
+	a.Announce("Maximum value reached", Assertive)
+
+	if log.String() != "50, 50 percent\nMaximum value reached\n" {
+		t.Errorf("Expected one line per announcement, got %q", log.String())
+	}
+	if bell.String() != "\a" {
+		t.Errorf("Expected a single bell for the assertive announcement, got %q", bell.String())
+	}
+}
+
+func TestMultiAnnouncer(t *testing.T) {
+	q1, q2 := NewQueueAnnouncer(), NewQueueAnnouncer()
+	MultiAnnouncer(q1, nil, q2).Announce("hi", Polite)
+
+	if q1.Len() != 1 || q2.Len() != 1 {
+		t.Error("Expected every announcer to receive the message")
+	}
+}
+
+func TestDebouncer(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	q := NewQueueAnnouncer()
+	d := NewDebouncer(q).WithDelay(100 * time.Millisecond).WithClock(clock)
+
+	// A burst of changes keeps only the latest
+	for _, msg := range []string{"1", "2", "3"} {
+		d.Announce(msg, Polite)
+		clock.Advance(60 * time.Millisecond)
+		if d.Flush() {
+			t.Fatalf("Should hold %q while changes keep arriving", msg)
+		}
+	}
+
+	clock.Advance(40 * time.Millisecond)
+	if !d.Flush() {
+		t.Fatal("Should deliver once changes pause for the delay")
+	}
+	if got := q.Messages(); !reflect.DeepEqual(got, []string{"3"}) {
+		t.Errorf("Expected only the latest value, got %v", got)
+	}
+	if d.Pending() {
+		t.Error("Nothing should be held after a flush")
+	}
+
+	// Assertive announcements go out at once and drop the held one
+	q.Clear()
+	d.Announce("4", Polite)
+	d.Announce("Maximum value reached", Assertive)
+	clock.Advance(time.Second)
+	d.Flush()
+	if got := q.Announcements(); len(got) != 1 || got[0].Politeness != Assertive {
+		t.Errorf("Expected only the assertive announcement, got %v", got)
+	}
+}
+
+func TestDebouncer_NilNext(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	d := NewDebouncer(nil).WithDelay(100 * time.Millisecond).WithClock(clock)
+
+	d.Announce("Maximum value reached", Assertive)
+	d.Announce("50", Polite)
+	clock.Advance(100 * time.Millisecond)
+	if d.HandleTick(AnnounceFlushMsg{ID: d.ID()}) != nil || d.Pending() {
+		t.Error("Expected the held announcement to be dropped")
+	}
+	d.Announce("60", Polite)
+	d.Drain()
+}
+
+func TestDebouncer_MaxWait(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	q := NewQueueAnnouncer()
+	d := NewDebouncer(q).WithDelay(100 * time.Millisecond).
+		WithMaxWait(250 * time.Millisecond).WithClock(clock)
+
+	for i := 0; i < 10; i++ {
+		d.Announce("value", Polite)
+		clock.Advance(50 * time.Millisecond)
+		d.Flush()
+	}
+
+	// 500ms of changes, announced after 250ms and again after 500ms
+	if q.Len() != 2 {
+		t.Errorf("Expected max wait to force 2 announcements, got %d", q.Len())
+	}
+}
+
+func TestDebouncer_Ticks(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	q := NewQueueAnnouncer()
+	d := NewDebouncer(q).WithDelay(100 * time.Millisecond).WithClock(clock)
+
+	if d.Tick() != nil {
+		t.Error("Tick should be nil with nothing held")
+	}
+
+	d.Announce("1", Polite)
+	if d.Tick() == nil {
+		t.Fatal("Tick should schedule a flush")
+	}
+	if d.Tick() != nil {
+		t.Error("Only one flush should be scheduled at a time")
+	}
+
+	// An early tick reschedules
+	if d.HandleTick(AnnounceFlushMsg{ID: d.ID()}) == nil {
+		t.Error("Expected another tick while the announcement is held")
+	}
+
+	clock.Advance(100 * time.Millisecond)
+	if cmd := d.HandleTick(AnnounceFlushMsg{ID: d.ID()}); cmd != nil {
+		t.Error("Expected no further ticks after the flush")
+	}
+	if q.Len() != 1 {
+		t.Errorf("Expected the announcement to be delivered, got %d", q.Len())
+	}
+
+	if d.HandleTick(AnnounceFlushMsg{ID: d.ID() + 1}) != nil {
+		t.Error("Ticks for other debouncers should be ignored")
+	}
+}
@@ -0,0 +1,380 @@
+package tuslide
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// ColorVision identifies a type of color vision to simulate.
+type ColorVision int
+
+const (
+	// NormalVision is typical trichromatic vision.
+	NormalVision ColorVision = iota
+	// Protanopia is red blindness (no L cones).
+	Protanopia
+	// Deuteranopia is green blindness (no M cones), the most common form.
+	Deuteranopia
+	// Tritanopia is blue blindness (no S cones).
+	Tritanopia
+)
+
+// ColorBlindVisions lists the color vision deficiencies audits check.
+var ColorBlindVisions = []ColorVision{Protanopia, Deuteranopia, Tritanopia}
+
+// String returns the name of the color vision.
+func (v ColorVision) String() string {
+	switch v {
+	case Protanopia:
+		return "protanopia"
+	case Deuteranopia:
+		return "deuteranopia"
+	case Tritanopia:
+		return "tritanopia"
+	}
+	return "normal"
+}
+
+// colorVisionMatrices are the full-severity simulation matrices of Machado,
+// Oliveira and Fernandes (2009), applied in linear RGB.
+var colorVisionMatrices = map[ColorVision][3][3]float64{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// SimulateColorVision returns how c appears with the given color vision.
+// Returns false if c has no RGB value.
+func SimulateColorVision(c lipgloss.TerminalColor, vision ColorVision) (lipgloss.Color, bool) {
+	rgb, ok := rgbOf(c)
+	if !ok {
+		return "", false
+	}
+	return lipgloss.Color(simulate(rgb, vision).Hex()), true
+}
+
+// simulate applies a color vision simulation to an sRGB color.
+func simulate(c colorful.Color, vision ColorVision) colorful.Color {
+	m, ok := colorVisionMatrices[vision]
+	if !ok {
+		return c
+	}
+	r, g, b := c.Clamped().LinearRgb()
+	return colorful.LinearRgb(
+		m[0][0]*r+m[0][1]*g+m[0][2]*b,
+		m[1][0]*r+m[1][1]*g+m[1][2]*b,
+		m[2][0]*r+m[2][1]*g+m[2][2]*b,
+	).Clamped()
+}
+
+// colorDistance returns the CIEDE2000 difference of two colors on the
+// usual 0-100 scale.
+func colorDistance(a, b colorful.Color) float64 {
+	return a.DistanceCIEDE2000(b) * 100
+}
+
+// Default StyleAuditor thresholds.
+const (
+	// DefaultMinTextContrast is the WCAG AA minimum for text.
+	DefaultMinTextContrast = ContrastRatioAA
+	// DefaultMinPartContrast is the WCAG minimum for non-text elements,
+	// used between parts of the track and against the background.
+	DefaultMinPartContrast = ContrastRatioAALarge
+	// DefaultMinColorDistance is the CIEDE2000 difference below which two
+	// colors are considered hard to tell apart at a glance.
+	DefaultMinColorDistance = 10.0
+)
+
+// StyleAuditor checks slider styles for contrast and color-blind safety
+// against an assumed terminal background.
+type StyleAuditor struct {
+	background      lipgloss.TerminalColor
+	palette         TerminalPalette
+	minTextContrast float64
+	minPartContrast float64
+	minDistance     float64
+}
+
+// NewStyleAuditor creates an auditor for styles shown on background.
+func NewStyleAuditor(background lipgloss.TerminalColor) *StyleAuditor {
+	return &StyleAuditor{
+		background:      background,
+		palette:         DefaultTerminalPalette,
+		minTextContrast: DefaultMinTextContrast,
+		minPartContrast: DefaultMinPartContrast,
+		minDistance:     DefaultMinColorDistance,
+	}
+}
+
+// WithPalette sets the terminal palette used for ANSI colors 0-15.
+func (a *StyleAuditor) WithPalette(palette TerminalPalette) *StyleAuditor {
+	a.palette = palette
+	return a
+}
+
+// WithMinTextContrast sets the contrast the label and value need against
+// the background.
+func (a *StyleAuditor) WithMinTextContrast(ratio float64) *StyleAuditor {
+	a.minTextContrast = ratio
+	return a
+}
+
+// WithMinPartContrast sets the contrast parts of the track need against
+// the background, and against each other when they share a symbol.
+func (a *StyleAuditor) WithMinPartContrast(ratio float64) *StyleAuditor {
+	a.minPartContrast = ratio
+	return a
+}
+
+// WithMinColorDistance sets the CIEDE2000 difference parts of the track
+// need under every simulated color vision when they share a symbol.
+func (a *StyleAuditor) WithMinColorDistance(distance float64) *StyleAuditor {
+	a.minDistance = distance
+	return a
+}
+
+// StyleCheck is the result of comparing two elements of a style.
+type StyleCheck struct {
+	Name       string // e.g. "filled/empty" or "label/background"
+	Foreground lipgloss.TerminalColor
+	Background lipgloss.TerminalColor
+
+	// Contrast under normal vision, with Pass against the minimum for
+	// this kind of check
+	Contrast ContrastResult
+
+	// Distance is the CIEDE2000 difference under each color vision.
+	Distance map[ColorVision]float64
+
+	// ShapeCue is true when the elements use different symbols, so they
+	// can be told apart without color.
+	ShapeCue bool
+
+	// Confusable lists the color visions under which the colors are hard
+	// to tell apart. With a shape cue the check passes regardless.
+	Confusable []ColorVision
+
+	Pass bool
+}
+
+// StyleReport is the result of auditing a style.
+type StyleReport struct {
+	Style  string
+	Checks []StyleCheck
+	Pass   bool
+}
+
+// Failures returns the checks that didn't pass.
+func (r StyleReport) Failures() []StyleCheck {
+	var failed []StyleCheck
+	for _, c := range r.Checks {
+		if !c.Pass {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// String summarizes the report, one line per check.
+func (r StyleReport) String() string {
+	var b strings.Builder
+	status := "PASS"
+	if !r.Pass {
+		status = "FAIL"
+	}
+	fmt.Fprintf(&b, "%s: %s", r.Style, status)
+
+	for _, c := range r.Checks {
+		mark := "ok"
+		if !c.Pass {
+			mark = "FAIL"
+		}
+		fmt.Fprintf(&b, "\n  %-18s %-5s %s", c.Name, mark, c.Contrast)
+		if c.ShapeCue {
+			b.WriteString(", distinct symbols")
+		}
+		if len(c.Confusable) > 0 {
+			names := make([]string, len(c.Confusable))
+			for i, v := range c.Confusable {
+				names[i] = v.String()
+			}
+			fmt.Fprintf(&b, ", confusable under %s", strings.Join(names, ", "))
+		}
+	}
+
+	return b.String()
+}
+
+// Audit checks a style: filled against empty and the handle against both
+// must be distinguishable, by symbol or by color under every simulated
+// color vision, each part of the track must stand out from the background,
+// and the label and value must be legible on it. Parts without a
+// foreground color are assumed to use the terminal's default, white on
+// dark backgrounds and black on light ones.
+func (a *StyleAuditor) Audit(style SliderStyle) StyleReport {
+	report := StyleReport{Style: style.Name, Pass: true}
+	sym := style.Symbols
+
+	checks := []StyleCheck{
+		a.checkParts("filled/empty", style.FilledStyle, style.EmptyStyle, sym.Filled != sym.Empty),
+		a.checkParts("handle/filled", style.HandleStyle, style.FilledStyle, sym.Handle != sym.Filled),
+		a.checkParts("handle/empty", style.HandleStyle, style.EmptyStyle, sym.Handle != sym.Empty),
+		a.checkTrack("filled/background", style.FilledStyle, sym.Filled),
+		a.checkTrack("empty/background", style.EmptyStyle, sym.Empty),
+		a.checkTrack("handle/background", style.HandleStyle, sym.Handle),
+		a.checkText("label/background", style.LabelStyle),
+		a.checkText("value/background", style.ValueStyle),
+	}
+
+	for _, c := range checks {
+		report.Checks = append(report.Checks, c)
+		report.Pass = report.Pass && c.Pass
+	}
+	return report
+}
+
+// AuditSlider audits the symbols and styles a slider is configured with.
+func (a *StyleAuditor) AuditSlider(slider *Slider) StyleReport {
+	return a.Audit(SliderStyle{
+		Name:        slider.label,
+		Symbols:     slider.symbols,
+		FilledStyle: slider.filledStyle,
+		EmptyStyle:  slider.emptyStyle,
+		HandleStyle: slider.handleStyle,
+		LabelStyle:  slider.labelStyle,
+		ValueStyle:  slider.valueStyle,
+	})
+}
+
+// AuditAll audits every style, e.g. AllStyles().
+func (a *StyleAuditor) AuditAll(styles []SliderStyle) []StyleReport {
+	reports := make([]StyleReport, len(styles))
+	for i, s := range styles {
+		reports[i] = a.Audit(s)
+	}
+	return reports
+}
+
+// checkParts compares two parts of the track. Different symbols are
+// enough on their own; otherwise the colors need contrast and must stay
+// apart under every color vision.
+func (a *StyleAuditor) checkParts(name string, fg, bg lipgloss.Style, shapeCue bool) StyleCheck {
+	check := a.compare(name, fg.GetForeground(), bg.GetForeground(), a.minPartContrast)
+	check.ShapeCue = shapeCue
+
+	fgRGB, bgRGB := a.rgb(check.Foreground), a.rgb(check.Background)
+	for _, v := range append([]ColorVision{NormalVision}, ColorBlindVisions...) {
+		d := colorDistance(simulate(fgRGB, v), simulate(bgRGB, v))
+		check.Distance[v] = d
+		if d < a.minDistance {
+			check.Confusable = append(check.Confusable, v)
+		}
+	}
+
+	check.Pass = shapeCue || (check.Contrast.Pass && len(check.Confusable) == 0)
+	return check
+}
+
+// checkTrack checks a part of the track against the background, as a
+// non-text element. Blank symbols draw nothing and always pass.
+func (a *StyleAuditor) checkTrack(name string, style lipgloss.Style, symbol string) StyleCheck {
+	check := a.checkBackground(name, style, a.minPartContrast)
+	if strings.TrimSpace(symbol) == "" {
+		check.Pass = true
+	}
+	return check
+}
+
+// checkText checks a text style against the background.
+func (a *StyleAuditor) checkText(name string, style lipgloss.Style) StyleCheck {
+	return a.checkBackground(name, style, a.minTextContrast)
+}
+
+// checkBackground checks a style's foreground against the background
+// under every color vision.
+func (a *StyleAuditor) checkBackground(name string, style lipgloss.Style, minRatio float64) StyleCheck {
+	check := a.compare(name, style.GetForeground(), a.backgroundColor(), minRatio)
+
+	fgRGB, bgRGB := a.rgb(check.Foreground), a.rgb(check.Background)
+	for _, v := range append([]ColorVision{NormalVision}, ColorBlindVisions...) {
+		sf, sb := simulate(fgRGB, v), simulate(bgRGB, v)
+		check.Distance[v] = colorDistance(sf, sb)
+		if contrast(sf, sb) < minRatio {
+			check.Confusable = append(check.Confusable, v)
+		}
+	}
+
+	check.Pass = check.Contrast.Pass && len(check.Confusable) == 0
+	return check
+}
+
+// compare builds a check of fg against bg, substituting the default
+// foreground for unset colors.
+func (a *StyleAuditor) compare(name string, fg, bg lipgloss.TerminalColor, minRatio float64) StyleCheck {
+	fg, bg = a.resolve(fg), a.resolve(bg)
+	ratio := contrast(a.rgb(fg), a.rgb(bg))
+
+	return StyleCheck{
+		Name:       name,
+		Foreground: fg,
+		Background: bg,
+		Contrast: ContrastResult{
+			Ratio: ratio,
+			Level: contrastLevel(ratio),
+			Pass:  ratio >= minRatio,
+		},
+		Distance: make(map[ColorVision]float64),
+	}
+}
+
+// resolve replaces colors without an RGB value by the assumed default
+// foreground.
+func (a *StyleAuditor) resolve(c lipgloss.TerminalColor) lipgloss.TerminalColor {
+	if _, ok := a.palette.rgb(c, a.dark()); ok {
+		return c
+	}
+	if a.dark() {
+		return lipgloss.Color("#ffffff")
+	}
+	return lipgloss.Color("#000000")
+}
+
+// backgroundColor returns the background, or black if it has no RGB value.
+func (a *StyleAuditor) backgroundColor() lipgloss.TerminalColor {
+	if _, ok := a.palette.rgb(a.background, true); ok {
+		return a.background
+	}
+	return lipgloss.Color("#000000")
+}
+
+// rgb converts a resolved color to RGB.
+func (a *StyleAuditor) rgb(c lipgloss.TerminalColor) colorful.Color {
+	rgb, _ := a.palette.rgb(c, a.dark())
+	return rgb
+}
+
+// dark reports whether the background contrasts more with white than
+// with black. An unresolvable background is assumed dark.
+func (a *StyleAuditor) dark() bool {
+	bg, ok := a.palette.rgb(a.background, true)
+	if !ok {
+		return true
+	}
+	white, black := colorful.Color{R: 1, G: 1, B: 1}, colorful.Color{}
+	return contrast(white, bg) > contrast(black, bg)
+}
@@ -0,0 +1,218 @@
+package tuslide
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSimulateColorVision(t *testing.T) {
+	red, green := lipgloss.Color("#ff0000"), lipgloss.Color("#00ff00")
+
+	normalRed, _ := SimulateColorVision(red, NormalVision)
+	if normalRed != "#ff0000" {
+		t.Errorf("Normal vision should not change colors, got %v", normalRed)
+	}
+
+	a, _ := rgbOf(red)
+	b, _ := rgbOf(green)
+	normal := colorDistance(a, b)
+	deutan := colorDistance(simulate(a, Deuteranopia), simulate(b, Deuteranopia))
+	if deutan >= normal {
+		t.Errorf("Red and green should be closer under deuteranopia: %f vs %f", deutan, normal)
+	}
+
+	// Grays look the same to everyone
+	for _, v := range ColorBlindVisions {
+		if got, _ := SimulateColorVision(lipgloss.Color("#808080"), v); got != "#808080" {
+			t.Errorf("%v: expected gray to stay gray, got %v", v, got)
+		}
+	}
+
+	if _, ok := SimulateColorVision(lipgloss.NoColor{}, Protanopia); ok {
+		t.Error("NoColor should not simulate")
+	}
+}
+
+func TestStyleAuditor_Audit(t *testing.T) {
+	auditor := NewStyleAuditor(lipgloss.Color("#000000"))
+
+	// Red and green parts drawn with the same symbol
+	style := SliderStyle{
+		Name:        "Traffic",
+		Symbols:     Symbols{Filled: "█", Empty: "█", Handle: "●"},
+		FilledStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#f03030")),
+		EmptyStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("#00a000")),
+		HandleStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")),
+		LabelStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")),
+		ValueStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")),
+	}
+
+	report := auditor.Audit(style)
+	if report.Pass {
+		t.Fatal("Red and green parts with the same symbol should fail")
+	}
+	if len(report.Checks) != 8 {
+		t.Fatalf("Expected 8 checks, got %d", len(report.Checks))
+	}
+
+	failures := report.Failures()
+	if len(failures) != 1 || failures[0].Name != "filled/empty" {
+		t.Fatalf("Expected only filled/empty to fail, got %v", failures)
+	}
+	if failures[0].ShapeCue {
+		t.Error("Same symbols should give no shape cue")
+	}
+	if len(failures[0].Distance) != 4 {
+		t.Errorf("Expected a distance for every color vision, got %v", failures[0].Distance)
+	}
+
+	// A distinct symbol is enough on its own
+	style.Symbols.Empty = "░"
+	if report := auditor.Audit(style); !report.Pass {
+		t.Errorf("Distinct symbols should pass:\n%s", report)
+	}
+}
+
+func TestStyleAuditor_Text(t *testing.T) {
+	style := SliderStyle{
+		Name:        "Dim",
+		Symbols:     DefaultSymbols(),
+		FilledStyle: lipgloss.NewStyle(),
+		EmptyStyle:  lipgloss.NewStyle(),
+		HandleStyle: lipgloss.NewStyle(),
+		LabelStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("#444444")),
+		ValueStyle:  lipgloss.NewStyle(),
+	}
+
+	report := NewStyleAuditor(lipgloss.Color("#000000")).Audit(style)
+	failures := report.Failures()
+	if len(failures) != 1 || failures[0].Name != "label/background" {
+		t.Fatalf("Expected only the dim label to fail, got:\n%s", report)
+	}
+	if len(failures[0].Confusable) != 4 {
+		t.Errorf("A dim label should fail under every vision, got %v", failures[0].Confusable)
+	}
+
+	// Unset colors use the terminal's default foreground
+	if value := report.Checks[7]; value.Foreground != lipgloss.Color("#ffffff") || !value.Pass {
+		t.Errorf("Expected unset value color to be white on black, got %v", value.Foreground)
+	}
+	light := NewStyleAuditor(lipgloss.Color("#ffffff")).Audit(style)
+	if value := light.Checks[7]; value.Foreground != lipgloss.Color("#000000") {
+		t.Errorf("Expected unset value color to be black on white, got %v", value.Foreground)
+	}
+
+	if s := report.String(); !strings.HasPrefix(s, "Dim: FAIL") || !strings.Contains(s, "label/background") {
+		t.Errorf("Unexpected report:\n%s", s)
+	}
+}
+
+// knownAuditFailures are the checks built-in styles fail on a black
+// background. Most draw the empty track in dark gray 240, just under 3:1;
+// the presets are kept as they are, so the list records them rather than
+// hiding them.
+var knownAuditFailures = map[string][]string{
+	"Default":             {"empty/background"},
+	"Block":               {"empty/background"},
+	"Dots":                {"empty/background"},
+	"Double Line":         {"empty/background"},
+	"Wave":                {"empty/background"},
+	"Progress":            {"empty/background"},
+	"Thick":               {"empty/background"},
+	"Gradient":            {"empty/background"},
+	"Retro":               {"empty/background"},
+	"Rounded":             {"empty/background"},
+	"Download":            {"empty/background"},
+	"Upload":              {"empty/background"},
+	"Health":              {"empty/background"},
+	"Mana":                {"empty/background"},
+	"Experience":          {"empty/background"},
+	"Loading":             {"empty/background"},
+	"Installation":        {"empty/background"},
+	"Battery":             {"empty/background"},
+	"Segmented":           {"filled/empty", "empty/background"},
+	"Segmented Blocks":    {"empty/background"},
+	"Segmented Dots":      {"empty/background"},
+	"Segmented Stars":     {"empty/background"},
+	"Segmented Squares":   {"empty/background"},
+	"Segmented Diamonds":  {"empty/background"},
+	"Segmented Bars":      {"empty/background"},
+	"Segmented Arrows":    {"empty/background"},
+	"Segmented Thick":     {"empty/background"},
+	"Ocean":               {"empty/background"},
+	"Forest":              {"empty/background"},
+	"Neon":                {"empty/background"},
+	"Monochrome":          {"empty/background"},
+	"Horizontal":          {"empty/background"},
+	"Horizontal Thick":    {"empty/background"},
+	"Horizontal Blocks":   {"empty/background"},
+	"Horizontal Gradient": {"empty/background"},
+	"Horizontal Dots":     {"handle/filled", "empty/background"},
+	"Horizontal Squares":  {"empty/background"},
+	"Horizontal Double":   {"empty/background"},
+	"Vertical":            {"empty/background"},
+	"Vertical Blocks":     {"empty/background"},
+	"Vertical Gradient":   {"empty/background"},
+	"Vertical Dots":       {"empty/background"},
+	"Vertical Squares":    {"empty/background"},
+	"Equalizer":           {"empty/background"},
+}
+
+func TestStyleAuditor_Track(t *testing.T) {
+	style := SliderStyle{
+		Name:        "Faded",
+		Symbols:     Symbols{Filled: "█", Empty: "░", Handle: "●"},
+		FilledStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")),
+		EmptyStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("236")),
+		HandleStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")),
+	}
+
+	auditor := NewStyleAuditor(lipgloss.Color("#000000"))
+	failures := auditor.Audit(style).Failures()
+	if len(failures) != 1 || failures[0].Name != "empty/background" {
+		t.Fatalf("Expected only the faded empty track to fail, got %v", failures)
+	}
+	if failures[0].Contrast.Ratio >= DefaultMinPartContrast {
+		t.Errorf("Expected contrast under 3:1, got %s", failures[0].Contrast)
+	}
+
+	// Blank symbols draw nothing to see
+	style.Symbols.Empty = " "
+	if report := auditor.Audit(style); !report.Pass {
+		t.Errorf("A blank empty track should pass:\n%s", report)
+	}
+}
+
+func TestBuiltinStyles_Audit(t *testing.T) {
+	auditor := NewStyleAuditor(lipgloss.Color("0"))
+	for _, report := range auditor.AuditAll(AllStyles()) {
+		var failed []string
+		for _, check := range report.Failures() {
+			failed = append(failed, check.Name)
+			t.Logf("%s: %s %s", report.Style, check.Name, check.Contrast)
+		}
+		if !reflect.DeepEqual(failed, knownAuditFailures[report.Style]) {
+			t.Errorf("Expected %s to fail %q, got:\n%s", report.Style, knownAuditFailures[report.Style], report)
+		}
+	}
+}
+
+func TestColorBlindSafePalettes(t *testing.T) {
+	palettes := map[string]HighContrastPalette{
+		"dark":  ColorBlindSafePalette(),
+		"light": ColorBlindSafeLightPalette(),
+	}
+
+	for name, palette := range palettes {
+		slider := New(NewState(), WithLabel(name))
+		ApplyPalette(slider, palette)
+
+		report := NewStyleAuditor(palette.Background).AuditSlider(slider)
+		if !report.Pass {
+			t.Errorf("%s", report)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package tuslide
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells animators what time it is. Animations use the real clock by
+// default; pass a ManualClock to step time precisely in tests and replays.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock reads the system clock.
+type realClock struct{}
+
+// Now returns the current wall-clock time.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// RealClock is the system clock, used when no other clock is configured.
+var RealClock Clock = realClock{}
+
+// ManualClock is a Clock that only moves when told to.
+// It is safe for concurrent use.
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewManualClock creates a manual clock set to start. A zero start uses a
+// fixed date so runs are reproducible.
+func NewManualClock(start time.Time) *ManualClock {
+	if start.IsZero() {
+		start = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return &ManualClock{now: start}
+}
+
+// Now returns the clock's current time.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set moves the clock to t.
+func (c *ManualClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// clockOrReal returns c, or RealClock if c is nil.
+func clockOrReal(c Clock) Clock {
+	if c == nil {
+		return RealClock
+	}
+	return c
+}
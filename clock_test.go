@@ -0,0 +1,48 @@
+package tuslide
+
+import (
+	"testing"
+	"time"
+)
+
+func TestManualClock(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewManualClock(start)
+
+	if !clock.Now().Equal(start) {
+		t.Errorf("Expected %v, got %v", start, clock.Now())
+	}
+
+	clock.Advance(1500 * time.Millisecond)
+	if got := clock.Now().Sub(start); got != 1500*time.Millisecond {
+		t.Errorf("Expected clock to advance 1.5s, got %v", got)
+	}
+
+	clock.Set(start)
+	if !clock.Now().Equal(start) {
+		t.Error("Set should move the clock")
+	}
+
+	if NewManualClock(time.Time{}).Now().IsZero() {
+		t.Error("A zero start should use a fixed non-zero date")
+	}
+}
+
+func TestRealClock(t *testing.T) {
+	before := time.Now()
+	now := RealClock.Now()
+	if now.Before(before) || now.Sub(before) > time.Second {
+		t.Errorf("RealClock should report the current time, got %v", now)
+	}
+}
+
+func TestClockOrReal(t *testing.T) {
+	if clockOrReal(nil) != RealClock {
+		t.Error("A nil clock should fall back to RealClock")
+	}
+
+	clock := NewManualClock(time.Time{})
+	if clockOrReal(clock) != Clock(clock) {
+		t.Error("A non-nil clock should be kept")
+	}
+}
@@ -0,0 +1,158 @@
+package tuslide
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/muesli/termenv"
+)
+
+// TerminalPalette holds the RGB values of the 16 ANSI colors as "#rrggbb"
+// hex strings. Terminals let users theme these colors, so the real values
+// of ANSI colors 0-15 depend on the terminal; configure the palette in use
+// for accurate contrast results.
+type TerminalPalette [16]string
+
+// DefaultTerminalPalette is the VGA-style palette Lip Gloss assumes when
+// converting ANSI colors to RGB.
+var DefaultTerminalPalette = TerminalPalette{
+	"#000000", "#800000", "#008000", "#808000", "#000080", "#800080", "#008080", "#c0c0c0",
+	"#808080", "#ff0000", "#00ff00", "#ffff00", "#0000ff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// XtermPalette is xterm's default palette.
+var XtermPalette = TerminalPalette{
+	"#000000", "#cd0000", "#00cd00", "#cdcd00", "#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+	"#7f7f7f", "#ff0000", "#00ff00", "#ffff00", "#5c5cff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// BlendColors mixes two colors in the perceptually uniform CIE L*a*b*
+// space: t=0 gives a and t=1 gives b. If either color can't be converted
+// to RGB (NoColor, for instance), the result switches from a to b half-way.
+func BlendColors(a, b lipgloss.TerminalColor, t float64) lipgloss.TerminalColor {
+	t = Clamp(t, 0, 1)
+
+	ca, okA := rgbOf(a)
+	cb, okB := rgbOf(b)
+	if !okA || !okB {
+		if t < 0.5 {
+			return a
+		}
+		return b
+	}
+
+	return lipgloss.Color(ca.BlendLab(cb, t).Clamped().Hex())
+}
+
+// RelativeLuminance returns the WCAG relative luminance of c, from 0 for
+// black to 1 for white, using the default palette and the detected
+// background for adaptive colors. Returns false if c has no RGB value.
+func RelativeLuminance(c lipgloss.TerminalColor) (float64, bool) {
+	rgb, ok := rgbOf(c)
+	if !ok {
+		return 0, false
+	}
+	return luminance(rgb), true
+}
+
+// ContrastRatio returns the WCAG contrast ratio between two colors, from 1
+// for identical luminance to 21 for black on white. Returns false if
+// either color has no RGB value. Use a ContrastChecker to configure the
+// terminal palette.
+func ContrastRatio(fg, bg lipgloss.TerminalColor) (float64, bool) {
+	a, okA := rgbOf(fg)
+	b, okB := rgbOf(bg)
+	if !okA || !okB {
+		return 0, false
+	}
+	return contrast(a, b), true
+}
+
+// luminance computes the WCAG 2 relative luminance of an sRGB color.
+func luminance(c colorful.Color) float64 {
+	linear := func(v float64) float64 {
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	c = c.Clamped()
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
+
+// contrast computes the WCAG contrast ratio of two sRGB colors.
+func contrast(a, b colorful.Color) float64 {
+	la, lb := luminance(a), luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// rgbOf converts a lipgloss color to RGB with the default palette. Unlike
+// TerminalColor.RGBA it doesn't depend on the terminal's color profile,
+// so colors convert the same way in tests and under NO_COLOR. Adaptive
+// colors resolve against the detected background; other colors never
+// query it, since the query reads from stdin, which Bubble Tea owns.
+func rgbOf(c lipgloss.TerminalColor) (colorful.Color, bool) {
+	if isAdaptive(c) {
+		return DefaultTerminalPalette.rgb(c, lipgloss.HasDarkBackground())
+	}
+	return DefaultTerminalPalette.rgb(c, true)
+}
+
+// isAdaptive reports whether c depends on the terminal background.
+func isAdaptive(c lipgloss.TerminalColor) bool {
+	switch c.(type) {
+	case lipgloss.AdaptiveColor, lipgloss.CompleteAdaptiveColor:
+		return true
+	}
+	return false
+}
+
+// rgb converts a lipgloss color to RGB, looking ANSI colors 0-15 up in the
+// palette and picking the dark or light side of adaptive colors. Returns
+// false for NoColor and unparseable colors.
+func (p TerminalPalette) rgb(c lipgloss.TerminalColor, dark bool) (colorful.Color, bool) {
+	switch v := c.(type) {
+	case lipgloss.Color:
+		return p.parse(string(v))
+	case lipgloss.ANSIColor:
+		return p.parse(strconv.Itoa(int(v)))
+	case lipgloss.AdaptiveColor:
+		if dark {
+			return p.parse(v.Dark)
+		}
+		return p.parse(v.Light)
+	case lipgloss.CompleteColor:
+		return p.parse(v.TrueColor)
+	case lipgloss.CompleteAdaptiveColor:
+		if dark {
+			return p.parse(v.Dark.TrueColor)
+		}
+		return p.parse(v.Light.TrueColor)
+	}
+	return colorful.Color{}, false
+}
+
+// parse parses a "#rgb" or "#rrggbb" hex color or an ANSI 256-color index.
+func (p TerminalPalette) parse(s string) (colorful.Color, bool) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "#") {
+		c, err := colorful.Hex(strings.ToLower(s))
+		return c, err == nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 255 {
+		return colorful.Color{}, false
+	}
+	if n < 16 {
+		c, err := colorful.Hex(strings.ToLower(p[n]))
+		return c, err == nil
+	}
+	return termenv.ConvertToRGB(termenv.ANSI256Color(n)), true
+}
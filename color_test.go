@@ -0,0 +1,84 @@
+package tuslide
+
+import (
+	"math"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestBlendColors(t *testing.T) {
+	black, white := lipgloss.Color("#000000"), lipgloss.Color("#ffffff")
+
+	if got := BlendColors(black, white, 0); got != black {
+		t.Errorf("t=0 should give the first color, got %v", got)
+	}
+	if got := BlendColors(black, white, 1); got != white {
+		t.Errorf("t=1 should give the second color, got %v", got)
+	}
+	if got := BlendColors(black, white, 0.5); got == black || got == white {
+		t.Errorf("t=0.5 should give a mix, got %v", got)
+	}
+
+	// ANSI indices and short hex convert too
+	if got := BlendColors(lipgloss.Color("15"), lipgloss.Color("#fff"), 0.5); got != white {
+		t.Errorf("Expected ANSI 15 and #fff to blend to white, got %v", got)
+	}
+
+	// Colors without RGB switch half-way
+	if got := BlendColors(lipgloss.NoColor{}, white, 0.4); got != (lipgloss.NoColor{}) {
+		t.Errorf("Expected NoColor before half-way, got %v", got)
+	}
+	if got := BlendColors(lipgloss.NoColor{}, white, 0.6); got != white {
+		t.Errorf("Expected white after half-way, got %v", got)
+	}
+}
+
+func TestTerminalPalette_Parse(t *testing.T) {
+	tests := []struct {
+		in string
+		ok bool
+	}{
+		{"#ff8800", true},
+		{"#F80", true},
+		{"196", true},
+		{"0", true},
+		{"256", false},
+		{"-1", false},
+		{"red", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if _, ok := DefaultTerminalPalette.parse(tt.in); ok != tt.ok {
+			t.Errorf("parse(%q): expected ok=%v", tt.in, tt.ok)
+		}
+	}
+}
+
+func TestRelativeLuminance(t *testing.T) {
+	tests := []struct {
+		c        lipgloss.TerminalColor
+		expected float64
+	}{
+		{lipgloss.Color("#000000"), 0},
+		{lipgloss.Color("#ffffff"), 1},
+		{lipgloss.Color("#808080"), 0.2159},
+		{lipgloss.Color("#ff0000"), 0.2126},
+		{lipgloss.Color("12"), 0.0722}, // Blue in the default palette
+	}
+
+	for _, tt := range tests {
+		got, ok := RelativeLuminance(tt.c)
+		if !ok || math.Abs(got-tt.expected) > 0.001 {
+			t.Errorf("RelativeLuminance(%v) = %f, expected %f", tt.c, got, tt.expected)
+		}
+	}
+
+	if _, ok := RelativeLuminance(lipgloss.NoColor{}); ok {
+		t.Error("NoColor should have no luminance")
+	}
+	if r, ok := ContrastRatio(lipgloss.Color("#000"), lipgloss.Color("#fff")); !ok || math.Abs(r-21) > 1e-9 {
+		t.Errorf("Expected 21:1, got %f", r)
+	}
+}
@@ -0,0 +1,383 @@
+package tuslide
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Easing builders return configurable EasingFuncs, matching the timing
+// functions of CSS so curves can be copied from design tools or config.
+
+// CubicBezier returns a CSS-style cubic-bezier(x1, y1, x2, y2) easing.
+// The curve starts at (0,0) and ends at (1,1); x1 and x2 are clamped to
+// [0,1] so the curve is a function of time, while y1 and y2 may overshoot.
+func CubicBezier(x1, y1, x2, y2 float64) EasingFunc {
+	x1 = Clamp(x1, 0, 1)
+	x2 = Clamp(x2, 0, 1)
+	if x1 == y1 && x2 == y2 {
+		return Linear
+	}
+
+	// Polynomial coefficients of B(t) = ((a*t + b)*t + c)*t
+	cx := 3 * x1
+	bx := 3*(x2-x1) - cx
+	ax := 1 - cx - bx
+	cy := 3 * y1
+	by := 3*(y2-y1) - cy
+	ay := 1 - cy - by
+
+	sampleX := func(t float64) float64 { return ((ax*t+bx)*t + cx) * t }
+	sampleY := func(t float64) float64 { return ((ay*t+by)*t + cy) * t }
+	slopeX := func(t float64) float64 { return (3*ax*t+2*bx)*t + cx }
+
+	// solve finds the curve parameter whose x is the given progress.
+	solve := func(x float64) float64 {
+		const epsilon = 1e-7
+
+		// Newton's method converges in a few steps for most curves...
+		t := x
+		for i := 0; i < 8; i++ {
+			dx := sampleX(t) - x
+			if math.Abs(dx) < epsilon {
+				return t
+			}
+			slope := slopeX(t)
+			if math.Abs(slope) < 1e-6 {
+				break
+			}
+			t -= dx / slope
+		}
+
+		// ...but flat sections stall it, so fall back to bisection, which
+		// always converges because x(t) is monotonic on [0,1].
+		lo, hi := 0.0, 1.0
+		t = x
+		for i := 0; i < 64; i++ {
+			dx := sampleX(t) - x
+			if math.Abs(dx) < epsilon {
+				break
+			}
+			if dx > 0 {
+				hi = t
+			} else {
+				lo = t
+			}
+			t = (lo + hi) / 2
+		}
+		return t
+	}
+
+	return func(t float64) float64 {
+		if t <= 0 {
+			return 0
+		}
+		if t >= 1 {
+			return 1
+		}
+		return sampleY(solve(t))
+	}
+}
+
+// CSS named timing functions.
+var (
+	// Ease is CSS "ease": a gentle start and a long deceleration.
+	Ease = CubicBezier(0.25, 0.1, 0.25, 1)
+	// EaseIn is CSS "ease-in".
+	EaseIn = CubicBezier(0.42, 0, 1, 1)
+	// EaseOut is CSS "ease-out".
+	EaseOut = CubicBezier(0, 0, 0.58, 1)
+	// EaseInOut is CSS "ease-in-out".
+	EaseInOut = CubicBezier(0.42, 0, 0.58, 1)
+)
+
+// StepPosition says where the jumps of a Steps easing happen.
+type StepPosition int
+
+const (
+	// JumpEnd holds each step for its whole interval and jumps at its end,
+	// so the first step is 0 (CSS "jump-end" or "end").
+	JumpEnd StepPosition = iota
+	// JumpStart jumps at the start of each interval, so the first step is
+	// already 1/n (CSS "jump-start" or "start").
+	JumpStart
+	// JumpNone holds both 0 and 1 for a full interval (CSS "jump-none").
+	JumpNone
+	// JumpBoth jumps at both ends, holding neither 0 nor 1 (CSS "jump-both").
+	JumpBoth
+)
+
+// Steps returns a CSS-style steps(n, position) easing that moves in n
+// discrete steps. n is raised to the minimum the position allows.
+func Steps(n int, position StepPosition) EasingFunc {
+	minSteps := 1
+	if position == JumpNone {
+		minSteps = 2
+	}
+	n = max(n, minSteps)
+
+	jumps := n
+	switch position {
+	case JumpNone:
+		jumps = n - 1
+	case JumpBoth:
+		jumps = n + 1
+	}
+
+	return func(t float64) float64 {
+		step := int(math.Floor(t * float64(n)))
+		if position == JumpStart || position == JumpBoth {
+			step++
+		}
+		if t >= 0 && step < 0 {
+			step = 0
+		}
+		if t <= 1 && step > jumps {
+			step = jumps
+		}
+		return float64(step) / float64(jumps)
+	}
+}
+
+// elasticShift returns the amplitude and phase shift for an elastic curve.
+// Amplitudes below 1 can't reach the target and are raised to 1.
+func elasticShift(amplitude, period float64) (float64, float64) {
+	if amplitude < 1 {
+		return 1, period / 4
+	}
+	return amplitude, period / (2 * math.Pi) * math.Asin(1/amplitude)
+}
+
+// ElasticOut returns an ease-out elastic easing that overshoots the target
+// and oscillates around it. amplitude (>= 1) scales the overshoot and
+// period sets the oscillation length as a fraction of the animation.
+// ElasticOut(1, 0.3) matches EaseOutElastic.
+func ElasticOut(amplitude, period float64) EasingFunc {
+	if period <= 0 {
+		period = 0.3
+	}
+	a, s := elasticShift(amplitude, period)
+
+	return func(t float64) float64 {
+		if t <= 0 {
+			return 0
+		}
+		if t >= 1 {
+			return 1
+		}
+		return a*math.Pow(2, -10*t)*math.Sin((t-s)*(2*math.Pi)/period) + 1
+	}
+}
+
+// ElasticIn returns an ease-in elastic easing, winding up before it moves.
+func ElasticIn(amplitude, period float64) EasingFunc {
+	out := ElasticOut(amplitude, period)
+	return func(t float64) float64 {
+		return 1 - out(1-t)
+	}
+}
+
+// ElasticInOut returns an elastic easing that winds up and overshoots.
+func ElasticInOut(amplitude, period float64) EasingFunc {
+	out := ElasticOut(amplitude, period)
+	return func(t float64) float64 {
+		if t < 0.5 {
+			return (1 - out(1-2*t)) / 2
+		}
+		return (1 + out(2*t-1)) / 2
+	}
+}
+
+// DefaultBackOvershoot is the classic back easing overshoot (about 10%).
+const DefaultBackOvershoot = 1.70158
+
+// BackIn returns an easing that pulls back before moving forward.
+// Larger overshoot values pull back further.
+func BackIn(overshoot float64) EasingFunc {
+	return func(t float64) float64 {
+		return t * t * ((overshoot+1)*t - overshoot)
+	}
+}
+
+// BackOut returns an easing that overshoots the target and settles back.
+func BackOut(overshoot float64) EasingFunc {
+	in := BackIn(overshoot)
+	return func(t float64) float64 {
+		return 1 - in(1-t)
+	}
+}
+
+// BackInOut returns an easing that pulls back at the start and overshoots
+// at the end.
+func BackInOut(overshoot float64) EasingFunc {
+	in := BackIn(overshoot * 1.525)
+	return func(t float64) float64 {
+		if t < 0.5 {
+			return in(2*t) / 2
+		}
+		return 1 - in(2-2*t)/2
+	}
+}
+
+// EaseInBack pulls back slightly before accelerating.
+func EaseInBack(t float64) float64 {
+	return BackIn(DefaultBackOvershoot)(t)
+}
+
+// EaseOutBack overshoots slightly before settling.
+func EaseOutBack(t float64) float64 {
+	return BackOut(DefaultBackOvershoot)(t)
+}
+
+// EaseInOutBack pulls back, then overshoots.
+func EaseInOutBack(t float64) float64 {
+	return BackInOut(DefaultBackOvershoot)(t)
+}
+
+// namedEasings maps the keywords accepted by ParseEasing to easings.
+var namedEasings = map[string]EasingFunc{
+	"linear":            Linear,
+	"ease":              Ease,
+	"ease-in":           EaseIn,
+	"ease-out":          EaseOut,
+	"ease-in-out":       EaseInOut,
+	"step-start":        Steps(1, JumpStart),
+	"step-end":          Steps(1, JumpEnd),
+	"ease-in-quad":      EaseInQuad,
+	"ease-out-quad":     EaseOutQuad,
+	"ease-in-out-quad":  EaseInOutQuad,
+	"ease-in-cubic":     EaseInCubic,
+	"ease-out-cubic":    EaseOutCubic,
+	"ease-in-out-cubic": EaseInOutCubic,
+	"ease-in-expo":      EaseInExpo,
+	"ease-out-expo":     EaseOutExpo,
+	"ease-in-out-expo":  EaseInOutExpo,
+	"ease-out-elastic":  EaseOutElastic,
+	"ease-in-bounce":    EaseInBounce,
+	"ease-out-bounce":   EaseOutBounce,
+	"ease-in-back":      EaseInBack,
+	"ease-out-back":     EaseOutBack,
+	"ease-in-out-back":  EaseInOutBack,
+}
+
+// stepPositions maps CSS step position keywords to StepPositions.
+var stepPositions = map[string]StepPosition{
+	"jump-start": JumpStart,
+	"start":      JumpStart,
+	"jump-end":   JumpEnd,
+	"end":        JumpEnd,
+	"jump-none":  JumpNone,
+	"jump-both":  JumpBoth,
+}
+
+// ParseEasing parses a CSS easing string into an EasingFunc.
+//
+// It accepts the CSS keywords (linear, ease, ease-in, ease-out,
+// ease-in-out, step-start, step-end), cubic-bezier(x1, y1, x2, y2) and
+// steps(n[, position]), the package's named easings in kebab case
+// (ease-out-bounce, ease-in-out-cubic, ...), and the builders
+// elastic-in/out/in-out(amplitude, period) and
+// back-in/out/in-out(overshoot). Names are case-insensitive.
+func ParseEasing(s string) (EasingFunc, error) {
+	spec := strings.ToLower(strings.TrimSpace(s))
+
+	if f, ok := namedEasings[spec]; ok {
+		return f, nil
+	}
+
+	open := strings.IndexByte(spec, '(')
+	if open < 0 || !strings.HasSuffix(spec, ")") {
+		return nil, fmt.Errorf("tuslide: unknown easing %q", s)
+	}
+
+	name := strings.TrimSpace(spec[:open])
+	var args []string
+	if inner := strings.TrimSpace(spec[open+1 : len(spec)-1]); inner != "" {
+		args = strings.Split(inner, ",")
+		for i := range args {
+			args[i] = strings.TrimSpace(args[i])
+		}
+	}
+
+	switch name {
+	case "cubic-bezier":
+		p, err := parseEasingArgs(s, args, 4)
+		if err != nil {
+			return nil, err
+		}
+		if p[0] < 0 || p[0] > 1 || p[2] < 0 || p[2] > 1 {
+			return nil, fmt.Errorf("tuslide: invalid easing %q: x values must be between 0 and 1", s)
+		}
+		return CubicBezier(p[0], p[1], p[2], p[3]), nil
+
+	case "steps":
+		if len(args) != 1 && len(args) != 2 {
+			return nil, fmt.Errorf("tuslide: invalid easing %q: steps takes 1 or 2 arguments", s)
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("tuslide: invalid easing %q: step count %q is not an integer", s, args[0])
+		}
+		position := JumpEnd
+		if len(args) == 2 {
+			var ok bool
+			if position, ok = stepPositions[args[1]]; !ok {
+				return nil, fmt.Errorf("tuslide: invalid easing %q: unknown step position %q", s, args[1])
+			}
+		}
+		if n < 1 || (position == JumpNone && n < 2) {
+			return nil, fmt.Errorf("tuslide: invalid easing %q: too few steps", s)
+		}
+		return Steps(n, position), nil
+
+	case "elastic-in", "elastic-out", "elastic-in-out":
+		p, err := parseEasingArgs(s, args, 2)
+		if err != nil {
+			return nil, err
+		}
+		if p[1] <= 0 {
+			return nil, fmt.Errorf("tuslide: invalid easing %q: period must be positive", s)
+		}
+		switch name {
+		case "elastic-in":
+			return ElasticIn(p[0], p[1]), nil
+		case "elastic-out":
+			return ElasticOut(p[0], p[1]), nil
+		}
+		return ElasticInOut(p[0], p[1]), nil
+
+	case "back-in", "back-out", "back-in-out":
+		p, err := parseEasingArgs(s, args, 1)
+		if err != nil {
+			return nil, err
+		}
+		switch name {
+		case "back-in":
+			return BackIn(p[0]), nil
+		case "back-out":
+			return BackOut(p[0]), nil
+		}
+		return BackInOut(p[0]), nil
+	}
+
+	return nil, fmt.Errorf("tuslide: unknown easing function %q", name)
+}
+
+// parseEasingArgs parses exactly n numeric easing arguments.
+func parseEasingArgs(s string, args []string, n int) ([]float64, error) {
+	if len(args) != n {
+		return nil, fmt.Errorf("tuslide: invalid easing %q: expected %d arguments, got %d", s, n, len(args))
+	}
+
+	values := make([]float64, n)
+	for i, arg := range args {
+		v, err := strconv.ParseFloat(arg, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("tuslide: invalid easing %q: %q is not a number", s, arg)
+		}
+		values[i] = v
+	}
+
+	return values, nil
+}
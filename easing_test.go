@@ -0,0 +1,221 @@
+package tuslide
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCubicBezier(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        EasingFunc
+		t        float64
+		expected float64
+	}{
+		{"ease start", Ease, 0, 0},
+		{"ease end", Ease, 1, 1},
+		{"ease 0.25", Ease, 0.25, 0.4094},
+		{"ease 0.5", Ease, 0.5, 0.8024},
+		{"ease-in-out symmetric", EaseInOut, 0.5, 0.5},
+		{"ease-in 0.5", EaseIn, 0.5, 0.3153},
+		{"ease-out 0.5", EaseOut, 0.5, 0.6847},
+		{"linear curve", CubicBezier(0.3, 0.3, 0.7, 0.7), 0.42, 0.42},
+		{"clamped below", Ease, -0.5, 0},
+		{"clamped above", Ease, 1.5, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f(tt.t)
+			if math.Abs(got-tt.expected) > 0.001 {
+				t.Errorf("f(%f) = %f, expected %f", tt.t, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCubicBezier_FlatCurvesAreMonotonic(t *testing.T) {
+	// Zero slope at both ends stalls Newton's method; the bisection
+	// fallback must still produce a smooth, monotonic curve.
+	curves := []EasingFunc{
+		CubicBezier(0, 1, 1, 0),
+		CubicBezier(1, 0, 0, 1),
+		CubicBezier(0.99, 0, 0.01, 1),
+	}
+
+	for i, f := range curves {
+		prev := 0.0
+		for x := 0.0; x <= 1.0; x += 0.001 {
+			y := f(x)
+			if math.IsNaN(y) {
+				t.Fatalf("Curve %d: f(%f) is NaN", i, x)
+			}
+			if y < prev-1e-6 {
+				t.Fatalf("Curve %d: f(%f) = %f decreased from %f", i, x, y, prev)
+			}
+			prev = y
+		}
+	}
+}
+
+func TestCubicBezier_Overshoot(t *testing.T) {
+	f := CubicBezier(0.34, 1.56, 0.64, 1)
+
+	peak := 0.0
+	for x := 0.0; x <= 1.0; x += 0.01 {
+		peak = math.Max(peak, f(x))
+	}
+	if peak <= 1 {
+		t.Errorf("Expected y values above 1 to overshoot, peak %f", peak)
+	}
+}
+
+func TestSteps(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        EasingFunc
+		inputs   []float64
+		expected []float64
+	}{
+		{"jump-end", Steps(4, JumpEnd),
+			[]float64{0, 0.2, 0.25, 0.6, 0.99, 1},
+			[]float64{0, 0, 0.25, 0.5, 0.75, 1}},
+		{"jump-start", Steps(4, JumpStart),
+			[]float64{0, 0.2, 0.25, 0.6, 1},
+			[]float64{0.25, 0.25, 0.5, 0.75, 1}},
+		{"jump-none", Steps(3, JumpNone),
+			[]float64{0, 0.3, 0.5, 0.9, 1},
+			[]float64{0, 0, 0.5, 1, 1}},
+		{"jump-both", Steps(3, JumpBoth),
+			[]float64{0, 0.5, 0.9, 1},
+			[]float64{0.25, 0.5, 0.75, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, in := range tt.inputs {
+				if got := tt.f(in); math.Abs(got-tt.expected[i]) > 0.001 {
+					t.Errorf("f(%f) = %f, expected %f", in, got, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestElastic(t *testing.T) {
+	for x := 0.0; x <= 1.0; x += 0.05 {
+		if math.Abs(ElasticOut(1, 0.3)(x)-EaseOutElastic(x)) > 1e-9 {
+			t.Fatalf("ElasticOut(1, 0.3) should match EaseOutElastic at %f", x)
+		}
+	}
+
+	peak := func(f EasingFunc) float64 {
+		p := 0.0
+		for x := 0.0; x <= 1.0; x += 0.001 {
+			p = math.Max(p, f(x))
+		}
+		return p
+	}
+	if peak(ElasticOut(2, 0.3)) <= peak(ElasticOut(1, 0.3)) {
+		t.Error("Larger amplitude should overshoot further")
+	}
+
+	for _, f := range []EasingFunc{ElasticIn(1.5, 0.4), ElasticOut(1.5, 0.4), ElasticInOut(1.5, 0.4)} {
+		if math.Abs(f(0)) > 0.001 || math.Abs(f(1)-1) > 0.001 {
+			t.Errorf("Elastic easing should run from 0 to 1, got %f..%f", f(0), f(1))
+		}
+	}
+}
+
+func TestBack(t *testing.T) {
+	minimum := func(f EasingFunc) float64 {
+		m := 0.0
+		for x := 0.0; x <= 1.0; x += 0.001 {
+			m = math.Min(m, f(x))
+		}
+		return m
+	}
+
+	if minimum(BackIn(DefaultBackOvershoot)) >= 0 {
+		t.Error("BackIn should dip below 0")
+	}
+	if minimum(BackIn(3)) >= minimum(BackIn(1)) {
+		t.Error("Larger overshoot should pull back further")
+	}
+
+	// About 10% overshoot with the default
+	if p := EaseOutBack(0.7); p < 1.05 || p > 1.11 {
+		t.Errorf("Expected EaseOutBack to overshoot ~10%%, got %f", p)
+	}
+
+	for _, f := range []EasingFunc{EaseInBack, EaseOutBack, EaseInOutBack} {
+		if math.Abs(f(0)) > 0.001 || math.Abs(f(1)-1) > 0.001 {
+			t.Errorf("Back easing should run from 0 to 1, got %f..%f", f(0), f(1))
+		}
+	}
+	if math.Abs(EaseInOutBack(0.5)-0.5) > 0.001 {
+		t.Errorf("EaseInOutBack should be symmetric, got %f", EaseInOutBack(0.5))
+	}
+}
+
+func TestParseEasing(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected EasingFunc
+	}{
+		{"linear", Linear},
+		{"ease", Ease},
+		{" Ease-In-Out ", EaseInOut},
+		{"step-start", Steps(1, JumpStart)},
+		{"step-end", Steps(1, JumpEnd)},
+		{"ease-out-bounce", EaseOutBounce},
+		{"cubic-bezier(0.25, 0.1, 0.25, 1)", Ease},
+		{"cubic-bezier(.34,1.56,.64,1)", CubicBezier(0.34, 1.56, 0.64, 1)},
+		{"steps(4)", Steps(4, JumpEnd)},
+		{"steps(4, jump-start)", Steps(4, JumpStart)},
+		{"steps(3, start)", Steps(3, JumpStart)},
+		{"steps(3, jump-none)", Steps(3, JumpNone)},
+		{"elastic-out(1, 0.3)", EaseOutElastic},
+		{"back-out(1.70158)", EaseOutBack},
+		{"back-in-out(2.5)", BackInOut(2.5)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			f, err := ParseEasing(tt.spec)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for x := 0.0; x <= 1.0; x += 0.1 {
+				if math.Abs(f(x)-tt.expected(x)) > 1e-9 {
+					t.Fatalf("f(%f) = %f, expected %f", x, f(x), tt.expected(x))
+				}
+			}
+		})
+	}
+}
+
+func TestParseEasing_Errors(t *testing.T) {
+	specs := []string{
+		"",
+		"wobble",
+		"cubic-bezier(0.1, 0.2, 0.3)",
+		"cubic-bezier(1.5, 0, 0.5, 1)",
+		"cubic-bezier(a, 0, 0.5, 1)",
+		"cubic-bezier(0.1, 0.2, 0.3, 0.4",
+		"steps()",
+		"steps(0)",
+		"steps(2.5)",
+		"steps(1, jump-none)",
+		"steps(4, sideways)",
+		"elastic-out(1, 0)",
+		"back-out()",
+		"spin(1)",
+	}
+
+	for _, spec := range specs {
+		if _, err := ParseEasing(spec); err == nil {
+			t.Errorf("ParseEasing(%q) should fail", spec)
+		}
+	}
+}
@@ -44,28 +44,27 @@ type model struct {
 	greenState *tuslide.SliderState
 	blueState  *tuslide.SliderState
 
-	// Mouse states for each slider
-	redMouse   *tuslide.MouseState
-	greenMouse *tuslide.MouseState
-	blueMouse  *tuslide.MouseState
-
-	// Which slider is focused (for keyboard control)
-	focused int
+	// Group tracks mouse bounds for all three sliders
+	group *tuslide.SliderGroup
 
 	// Track cursor position for display
 	cursorX, cursorY int
 }
 
 func newModel() model {
-	return model{
+	m := model{
 		redState:   tuslide.NewState(tuslide.WithValue(128), tuslide.WithMin(0), tuslide.WithMax(255)),
 		greenState: tuslide.NewState(tuslide.WithValue(128), tuslide.WithMin(0), tuslide.WithMax(255)),
 		blueState:  tuslide.NewState(tuslide.WithValue(128), tuslide.WithMin(0), tuslide.WithMax(255)),
-		redMouse:   tuslide.NewMouseState(),
-		greenMouse: tuslide.NewMouseState(),
-		blueMouse:  tuslide.NewMouseState(),
-		focused:    0,
+		group:      tuslide.NewSliderGroup(),
 	}
+
+	m.group.Add(createSlider(m.redState, "Red", "205"))
+	m.group.Add(createSlider(m.greenState, "Green", "82"))
+	m.group.Add(createSlider(m.blueState, "Blue", "39"))
+	m.group.SetFocused(0)
+
+	return m
 }
 
 func (m model) Init() tea.Cmd {
@@ -79,9 +78,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "q", "ctrl+c":
 			return m, tea.Quit
 		case "tab":
-			m.focused = (m.focused + 1) % 3
+			m.group.SetFocused((m.focused() + 1) % 3)
 		case "shift+tab":
-			m.focused = (m.focused - 1 + 3) % 3
+			m.group.SetFocused((m.focused() - 1 + 3) % 3)
 		case "left", "h":
 			m.currentState().Decrement()
 		case "right", "l":
@@ -102,40 +101,36 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.cursorX = msg.X
 		m.cursorY = msg.Y
 
-		// Handle mouse for each slider
-		// The bounds are set in View() - we need to track them
-		if m.redMouse.HandleMouse(msg, m.createSlider(m.redState, "205")) {
-			m.focused = 0
-		} else if m.greenMouse.HandleMouse(msg, m.createSlider(m.greenState, "82")) {
-			m.focused = 1
-		} else if m.blueMouse.HandleMouse(msg, m.createSlider(m.blueState, "39")) {
-			m.focused = 2
-		}
+		// Bounds are discovered automatically in View() via group.Scan
+		m.group.HandleMouse(msg)
 	}
 
 	return m, nil
 }
 
-func (m model) currentState() *tuslide.SliderState {
-	switch m.focused {
-	case 0:
-		return m.redState
-	case 1:
-		return m.greenState
-	case 2:
-		return m.blueState
+func (m model) focused() int {
+	if f := m.group.Focused(); f >= 0 {
+		return f
 	}
-	return m.redState
+	return 0
 }
 
-func (m model) createSlider(state *tuslide.SliderState, color string) *tuslide.Slider {
+func (m model) currentState() *tuslide.SliderState {
+	return m.group.Get(m.focused()).State()
+}
+
+func createSlider(state *tuslide.SliderState, label, color string) *tuslide.Slider {
 	return tuslide.New(state,
 		tuslide.WithWidth(30),
 		tuslide.WithShowValue(true),
 		tuslide.WithValueFormat("%.0f"),
+		tuslide.WithLabel(label),
+		tuslide.WithLabelPosition(tuslide.LabelLeft),
 		tuslide.WithFilledStyle(lipgloss.NewStyle().Foreground(lipgloss.Color(color))),
 		tuslide.WithEmptyStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("238"))),
 		tuslide.WithHandleStyle(lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Bold(true)),
+		tuslide.WithLabelStyle(labelStyle),
+		tuslide.WithValueStyle(valueStyle),
 	)
 }
 
@@ -164,75 +159,19 @@ func (m model) View() string {
 		Align(lipgloss.Center, lipgloss.Center).
 		Render(colorHex)
 
-	// Build sliders
-	// Red slider
-	redSlider := tuslide.New(m.redState,
-		tuslide.WithWidth(30),
-		tuslide.WithShowValue(true),
-		tuslide.WithValueFormat("%.0f"),
-		tuslide.WithLabel("Red"),
-		tuslide.WithLabelPosition(tuslide.LabelLeft),
-		tuslide.WithFilledStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("205"))),
-		tuslide.WithEmptyStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("238"))),
-		tuslide.WithHandleStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true)),
-		tuslide.WithLabelStyle(labelStyle),
-		tuslide.WithValueStyle(valueStyle),
-	)
-
-	// Green slider
-	greenSlider := tuslide.New(m.greenState,
-		tuslide.WithWidth(30),
-		tuslide.WithShowValue(true),
-		tuslide.WithValueFormat("%.0f"),
-		tuslide.WithLabel("Green"),
-		tuslide.WithLabelPosition(tuslide.LabelLeft),
-		tuslide.WithFilledStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("82"))),
-		tuslide.WithEmptyStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("238"))),
-		tuslide.WithHandleStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("82")).Bold(true)),
-		tuslide.WithLabelStyle(labelStyle),
-		tuslide.WithValueStyle(valueStyle),
-	)
-
-	// Blue slider
-	blueSlider := tuslide.New(m.blueState,
-		tuslide.WithWidth(30),
-		tuslide.WithShowValue(true),
-		tuslide.WithValueFormat("%.0f"),
-		tuslide.WithLabel("Blue"),
-		tuslide.WithLabelPosition(tuslide.LabelLeft),
-		tuslide.WithFilledStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("39"))),
-		tuslide.WithEmptyStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("238"))),
-		tuslide.WithHandleStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Bold(true)),
-		tuslide.WithLabelStyle(labelStyle),
-		tuslide.WithValueStyle(valueStyle),
-	)
-
-	// Render sliders with focus indication
-	redBox := boxStyle
-	greenBox := boxStyle
-	blueBox := boxStyle
-
-	switch m.focused {
-	case 0:
-		redBox = focusedBoxStyle
-	case 1:
-		greenBox = focusedBoxStyle
-	case 2:
-		blueBox = focusedBoxStyle
+	// Render sliders with focus indication. group.View marks each slider
+	// so its track position can be found after layout.
+	boxes := make([]string, m.group.Count())
+	for i := range boxes {
+		box := boxStyle
+		if i == m.focused() {
+			box = focusedBoxStyle
+		}
+		boxes[i] = box.Render(m.group.View(i))
 	}
 
-	// Update mouse bounds based on approximate positions
-	// In a real app, you'd calculate these more precisely
-	m.redMouse.SetBounds(10, 5, 30, 1)
-	m.greenMouse.SetBounds(10, 8, 30, 1)
-	m.blueMouse.SetBounds(10, 11, 30, 1)
-
 	// Layout
-	sliders := lipgloss.JoinVertical(lipgloss.Left,
-		redBox.Render(redSlider.View()),
-		greenBox.Render(greenSlider.View()),
-		blueBox.Render(blueSlider.View()),
-	)
+	sliders := lipgloss.JoinVertical(lipgloss.Left, boxes...)
 
 	// Side by side: sliders and color preview
 	content := lipgloss.JoinHorizontal(lipgloss.Top,
@@ -247,11 +186,12 @@ func (m model) View() string {
 	// Debug info
 	b.WriteString(helpStyle.Render(fmt.Sprintf("Mouse: (%d, %d) • Focused: %s",
 		m.cursorX, m.cursorY,
-		[]string{"Red", "Green", "Blue"}[m.focused])))
+		[]string{"Red", "Green", "Blue"}[m.focused()])))
 	b.WriteString("\n")
 	b.WriteString(helpStyle.Render("Press 'q' to quit"))
 
-	return b.String()
+	// Strip zone markers and update every slider's mouse bounds
+	return m.group.Scan(b.String())
 }
 
 func main() {
@@ -0,0 +1,263 @@
+package tuslide
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// InteractionState is a state of an AccessibleSlider that its view shows
+// with StateCues.
+type InteractionState int
+
+const (
+	// InteractionFocused is set while the slider has focus.
+	InteractionFocused InteractionState = iota
+	// InteractionDisabled is set while the slider can't be changed.
+	InteractionDisabled
+	// InteractionDragging is set while the pointer drags the handle.
+	InteractionDragging
+	// InteractionAtBoundary is set while the value is at the minimum or
+	// maximum.
+	InteractionAtBoundary
+)
+
+// String returns the name of the state.
+func (s InteractionState) String() string {
+	switch s {
+	case InteractionFocused:
+		return "focused"
+	case InteractionDisabled:
+		return "disabled"
+	case InteractionDragging:
+		return "dragging"
+	case InteractionAtBoundary:
+		return "at boundary"
+	}
+	return "unknown"
+}
+
+// markerPriority orders states when more than one wants a marker: the
+// first active state with a marker wins.
+var markerPriority = []InteractionState{
+	InteractionDisabled, InteractionDragging, InteractionFocused, InteractionAtBoundary,
+}
+
+// StateCue is a set of ways to show a state without relying on color.
+// Cues combine with |, and the cues of all active states add up.
+type StateCue int
+
+const (
+	// CueMarker draws the state's marker glyph beside the slider.
+	CueMarker StateCue = 1 << iota
+	// CueBrackets wraps the slider in square brackets.
+	CueBrackets
+	// CueUnderline underlines the track.
+	CueUnderline
+	// CueBold draws the track in bold.
+	CueBold
+	// CueReverse draws the handle in reverse video.
+	CueReverse
+	// CueBorder switches a bordered slider to a double border (a thick one
+	// if it already has a double border). Sliders without a border keep
+	// their layout.
+	CueBorder
+
+	// CueNone shows nothing.
+	CueNone StateCue = 0
+)
+
+// Has reports whether c includes all of cue.
+func (c StateCue) Has(cue StateCue) bool {
+	return c&cue == cue
+}
+
+// StateIndicators configures how an AccessibleSlider shows its states.
+type StateIndicators struct {
+	Position IndicatorPosition // Where markers go
+	Style    lipgloss.Style    // Style of markers
+
+	Cues    map[InteractionState]StateCue
+	Markers map[InteractionState]string
+}
+
+// DefaultStateIndicators returns the indicators for an accessibility
+// mode. AccessibilityScreenReader shows none: its view is text.
+func DefaultStateIndicators(mode AccessibilityMode) StateIndicators {
+	ind := StateIndicators{
+		Position: IndicatorLeft,
+		Style:    lipgloss.NewStyle().Foreground(lipgloss.Color("11")),
+		Cues: map[InteractionState]StateCue{
+			InteractionFocused:  CueMarker,
+			InteractionDisabled: CueMarker,
+			InteractionDragging: CueReverse,
+		},
+		Markers: map[InteractionState]string{
+			InteractionFocused:  "▸",
+			InteractionDisabled: "×",
+		},
+	}
+
+	switch mode {
+	case AccessibilityHighContrast:
+		ind.Style = ind.Style.Bold(true)
+		ind.Cues = map[InteractionState]StateCue{
+			InteractionFocused:    CueMarker | CueBold | CueBorder,
+			InteractionDisabled:   CueMarker,
+			InteractionDragging:   CueReverse | CueUnderline,
+			InteractionAtBoundary: CueBrackets,
+		}
+	case AccessibilityASCII:
+		ind.Cues = map[InteractionState]StateCue{
+			InteractionFocused:    CueMarker | CueBrackets,
+			InteractionDisabled:   CueMarker,
+			InteractionDragging:   CueReverse,
+			InteractionAtBoundary: CueUnderline,
+		}
+		ind.Markers = map[InteractionState]string{
+			InteractionFocused:  ">",
+			InteractionDisabled: "x",
+		}
+	case AccessibilityScreenReader:
+		ind.Cues = map[InteractionState]StateCue{}
+	}
+
+	return ind
+}
+
+// WithStateIndicators replaces the indicators of the accessibility mode.
+func WithStateIndicators(ind StateIndicators) AccessibleOption {
+	return func(a *AccessibleSlider) {
+		a.indicators = &ind
+	}
+}
+
+// WithStateCue sets the cues for one state, keeping the mode's cues for
+// the others.
+func WithStateCue(state InteractionState, cue StateCue) AccessibleOption {
+	return func(a *AccessibleSlider) {
+		if a.stateCues == nil {
+			a.stateCues = make(map[InteractionState]StateCue)
+		}
+		a.stateCues[state] = cue
+	}
+}
+
+// Indicators returns the indicators the slider uses.
+func (a *AccessibleSlider) Indicators() StateIndicators {
+	var ind StateIndicators
+	if a.indicators != nil {
+		ind = *a.indicators
+	} else {
+		ind = DefaultStateIndicators(a.mode)
+	}
+
+	if len(a.stateCues) > 0 {
+		cues := make(map[InteractionState]StateCue, len(ind.Cues)+len(a.stateCues))
+		for s, c := range ind.Cues {
+			cues[s] = c
+		}
+		for s, c := range a.stateCues {
+			cues[s] = c
+		}
+		ind.Cues = cues
+	}
+	return ind
+}
+
+// SetDragging sets whether the pointer is dragging the handle, e.g. from
+// MouseState.Dragging.
+func (a *AccessibleSlider) SetDragging(dragging bool) {
+	a.dragging = dragging
+}
+
+// IsDragging returns whether the slider is being dragged.
+func (a *AccessibleSlider) IsDragging() bool {
+	return a.dragging
+}
+
+// InteractionStates returns the slider's active states.
+func (a *AccessibleSlider) InteractionStates() []InteractionState {
+	var states []InteractionState
+	if a.focused {
+		states = append(states, InteractionFocused)
+	}
+	if a.disabled() {
+		states = append(states, InteractionDisabled)
+	}
+	if a.dragging {
+		states = append(states, InteractionDragging)
+	}
+	if a.GetBoundaryAnnouncement() != "" {
+		states = append(states, InteractionAtBoundary)
+	}
+	return states
+}
+
+// disabled reports whether the slider is disabled.
+func (a *AccessibleSlider) disabled() bool {
+	return a.slider.disabled
+}
+
+// renderWithCues renders the slider with the cues of its active states.
+func (a *AccessibleSlider) renderWithCues() string {
+	ind := a.Indicators()
+	states := a.InteractionStates()
+
+	var cue StateCue
+	active := make(map[InteractionState]bool, len(states))
+	for _, s := range states {
+		cue |= ind.Cues[s]
+		active[s] = true
+	}
+
+	// Style cues apply to the slider for this render only
+	s := a.slider
+	filled, empty, handle, border := s.filledStyle, s.emptyStyle, s.handleStyle, s.borderStyle
+	defer func() {
+		s.filledStyle, s.emptyStyle, s.handleStyle, s.borderStyle = filled, empty, handle, border
+	}()
+
+	if cue.Has(CueUnderline) {
+		s.filledStyle = s.filledStyle.Underline(true)
+		s.emptyStyle = s.emptyStyle.Underline(true)
+	}
+	if cue.Has(CueBold) {
+		s.filledStyle = s.filledStyle.Bold(true)
+		s.emptyStyle = s.emptyStyle.Bold(true)
+	}
+	if cue.Has(CueReverse) {
+		s.handleStyle = s.handleStyle.Reverse(true)
+	}
+	if cue.Has(CueBorder) && s.borderStyle != BorderNone {
+		if s.borderStyle == BorderDouble {
+			s.borderStyle = BorderThick
+		} else {
+			s.borderStyle = BorderDouble
+		}
+	}
+
+	// Brackets and markers are drawn through the slider, so its track and
+	// handle positions account for them
+	return s.present(func(view string) string {
+		if cue.Has(CueBrackets) {
+			lines := strings.Split(view, "\n")
+			for i, line := range lines {
+				lines[i] = "[" + line + "]"
+			}
+			view = strings.Join(lines, "\n")
+		}
+
+		if cue.Has(CueMarker) {
+			for _, state := range markerPriority {
+				marker := ind.Markers[state]
+				if active[state] && ind.Cues[state].Has(CueMarker) && marker != "" {
+					fi := &FocusIndicator{style: ind.Style, char: marker, position: ind.Position}
+					view = fi.Wrap(view, true)
+					break
+				}
+			}
+		}
+		return view
+	})
+}
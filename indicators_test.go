@@ -0,0 +1,138 @@
+package tuslide
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestAccessibleSlider_InteractionStates(t *testing.T) {
+	state := NewState(WithValue(50), WithMax(100))
+	accessible := NewAccessibleSlider(New(state))
+
+	if len(accessible.InteractionStates()) != 0 {
+		t.Errorf("Expected no states, got %v", accessible.InteractionStates())
+	}
+
+	accessible.SetFocused(true)
+	accessible.SetDragging(true)
+	state.SetValue(100)
+
+	expected := []InteractionState{InteractionFocused, InteractionDragging, InteractionAtBoundary}
+	if got := accessible.InteractionStates(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestAccessibleSlider_StateCues(t *testing.T) {
+	state := NewState(WithValue(50), WithMax(100))
+	slider := New(state, WithWidth(10))
+	accessible := NewAccessibleSlider(slider,
+		WithAccessibilityMode(AccessibilityASCII),
+	)
+	accessible.SetFocused(true)
+
+	// ASCII mode: marker and brackets when focused
+	if view := accessible.View(); view != "> [====O-----]" {
+		t.Errorf("Unexpected focused view: %q", view)
+	}
+
+	// At the maximum the track is underlined, which the test renderer
+	// doesn't draw; the text is unchanged
+	state.SetValue(100)
+	if view := accessible.View(); view != "> [=========O]" {
+		t.Errorf("Unexpected view at the maximum: %q", view)
+	}
+
+	accessible.SetFocused(false)
+	if view := accessible.View(); view != "=========O" {
+		t.Errorf("Unexpected unfocused view: %q", view)
+	}
+}
+
+func TestAccessibleSlider_StyleCuesAreTemporary(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	slider := New(NewState(WithValue(50)), WithWidth(10))
+	accessible := NewAccessibleSlider(slider)
+	accessible.SetDragging(true)
+
+	// Reverse video is SGR 7
+	if view := accessible.View(); !strings.Contains(view, "\x1b[7m") {
+		t.Errorf("Expected a reversed handle while dragging, got %q", view)
+	}
+	if slider.handleStyle.GetReverse() {
+		t.Error("Cues should not change the slider's own styles")
+	}
+	if view := slider.View(); strings.Contains(view, "\x1b[7m") {
+		t.Errorf("Expected no reverse video outside the accessible view, got %q", view)
+	}
+}
+
+func TestAccessibleSlider_BorderCue(t *testing.T) {
+	slider := New(NewState(), WithWidth(10), WithBorder(BorderRounded))
+	accessible := NewAccessibleSlider(slider,
+		WithAccessibilityMode(AccessibilityHighContrast),
+	)
+
+	accessible.SetFocused(true)
+	if view := accessible.View(); !strings.Contains(view, "╔") {
+		t.Errorf("Expected a double border when focused, got %q", view)
+	}
+	if slider.borderStyle != BorderRounded {
+		t.Error("The slider's border should be restored")
+	}
+
+	accessible.SetFocused(false)
+	if view := accessible.View(); !strings.Contains(view, "╭") {
+		t.Errorf("Expected the rounded border when unfocused, got %q", view)
+	}
+}
+
+func TestAccessibleSlider_CustomIndicators(t *testing.T) {
+	ind := DefaultStateIndicators(AccessibilityDefault)
+	ind.Position = IndicatorBoth
+	ind.Markers[InteractionFocused] = "*"
+
+	slider := New(NewState(WithValue(50)), WithWidth(10))
+	accessible := NewAccessibleSlider(slider,
+		WithStateIndicators(ind),
+		WithStateCue(InteractionFocused, CueMarker|CueBrackets),
+	)
+	accessible.SetFocused(true)
+
+	if view := accessible.View(); view != "* [████●░░░░░] *" {
+		t.Errorf("Unexpected view: %q", view)
+	}
+	if cue := accessible.Indicators().Cues[InteractionDragging]; cue != CueReverse {
+		t.Errorf("Other states should keep their cues, got %v", cue)
+	}
+
+	// A state without cues draws nothing
+	accessible = NewAccessibleSlider(slider, WithStateCue(InteractionFocused, CueNone))
+	accessible.SetFocused(true)
+	if view := accessible.View(); view != slider.View() {
+		t.Errorf("Expected the plain slider, got %q", view)
+	}
+}
+
+func TestFocusIndicator_TypedPositions(t *testing.T) {
+	fi := NewFocusIndicator().WithStyle(lipgloss.NewStyle()).WithChar("*")
+
+	tests := map[IndicatorPosition]string{
+		IndicatorLeft:  "* x",
+		IndicatorRight: "x *",
+		IndicatorBoth:  "* x *",
+		"middle":       "* x", // Unknown positions fall back to the left
+	}
+	for pos, expected := range tests {
+		if got := fi.WithPosition(pos).Wrap("x", true); got != expected {
+			t.Errorf("%s: expected %q, got %q", pos, expected, got)
+		}
+	}
+}
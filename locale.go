@@ -0,0 +1,378 @@
+package tuslide
+
+import (
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Messages are the templates for a locale's announcements. Placeholders in
+// braces are replaced when a message is built:
+//
+//	{label}          the slider's label, or Messages.Label if it has none
+//	{name}           Named with the label, or Messages.Label
+//	{value}          the value, with its unit if one is set
+//	{min}, {max}     the bounds
+//	{step}           the step size
+//	{percent}        the percentage written out, e.g. "50%"
+//	{spoken_percent} the percentage for speech, e.g. "50 percent"
+//	{description}    the description (Focus, Described)
+//	{announcement}   the value announcement (Boundary only)
+//	{boundary}       Minimum or Maximum (Boundary only)
+//	{n}              the number (Percent, SpokenPercent)
+//	{unit}           the unit name (Unit only)
+//	{text}           a slider's text (Focused, Described, ListItem, Disabled,
+//	                 ReadOnly)
+//	{index}          the position in a group, from 1 (ListItem only)
+type Messages struct {
+	Label         string // Name for sliders without a label
+	Description   string
+	Value         string
+	Focus         string
+	Minimum       string
+	Maximum       string
+	Boundary      string // Value announcement on reaching a bound
+	Progress      string
+	Complete      string
+	Percent       string
+	SpokenPercent string
+	Unit          string // A value with its unit
+
+	// Text renderings for screen readers (see AccessibleSlider.Text)
+	Named       string // A labelled slider, e.g. "{label} slider"
+	Text        string
+	CompactText string
+	Busy        string // Text in indeterminate mode
+	Focused     string // Text of a focused slider
+	Described   string // Text followed by the slider's description
+	ListItem    string // Line of a group's text
+	Disabled    string // Text or announcement of a disabled slider
+	ReadOnly    string // Text or announcement of a read-only slider
+}
+
+// Locale is a language's messages and number format.
+type Locale struct {
+	Tag              string // Language tag, e.g. "de"
+	DecimalSeparator string
+	GroupSeparator   string // Between thousands; empty for none
+	Messages         Messages
+
+	// IsOne reports whether v takes the singular unit name. Nil uses v == 1.
+	IsOne func(v float64) bool
+}
+
+// Narrow and regular no-break spaces used by some locales' number formats.
+const (
+	nbsp       = "\u00a0"
+	narrowNbsp = "\u202f"
+)
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{
+		"en": {
+			Tag:              "en",
+			DecimalSeparator: ".",
+			GroupSeparator:   ",",
+			Messages: Messages{
+				Label:         "Slider",
+				Description:   "{label}: {value} of {max} ({percent})",
+				Value:         "{value}, {spoken_percent}",
+				Focus:         "{description}. Use arrow keys to adjust.",
+				Minimum:       "Minimum value reached",
+				Maximum:       "Maximum value reached",
+				Boundary:      "{announcement}. {boundary}",
+				Progress:      "{spoken_percent} complete",
+				Complete:      "Complete",
+				Percent:       "{n}%",
+				SpokenPercent: "{n} percent",
+				Unit:          "{value} {unit}",
+				Named:         "{label} slider",
+				Text:          "{name}, {value} of {max}, {spoken_percent}, step {step}",
+				CompactText:   "{label}: {value}/{max}",
+				Busy:          "{name}, busy",
+				Focused:       "{text}, focused",
+				Described:     "{text}. {description}",
+				ListItem:      "{index}. {text}",
+				Disabled:      "{text}, disabled",
+				ReadOnly:      "{text}, read-only",
+			},
+		},
+		"de": {
+			Tag:              "de",
+			DecimalSeparator: ",",
+			GroupSeparator:   ".",
+			Messages: Messages{
+				Label:         "Schieberegler",
+				Description:   "{label}: {value} von {max} ({percent})",
+				Value:         "{value}, {spoken_percent}",
+				Focus:         "{description}. Mit den Pfeiltasten anpassen.",
+				Minimum:       "Minimalwert erreicht",
+				Maximum:       "Maximalwert erreicht",
+				Boundary:      "{announcement}. {boundary}",
+				Progress:      "{spoken_percent} abgeschlossen",
+				Complete:      "Abgeschlossen",
+				Percent:       "{n}" + nbsp + "%",
+				SpokenPercent: "{n} Prozent",
+				Unit:          "{value} {unit}",
+				Named:         "Schieberegler {label}",
+				Text:          "{name}, {value} von {max}, {spoken_percent}, Schrittweite {step}",
+				CompactText:   "{label}: {value}/{max}",
+				Busy:          "{name}, beschäftigt",
+				Focused:       "{text}, fokussiert",
+				Described:     "{text}. {description}",
+				ListItem:      "{index}. {text}",
+				Disabled:      "{text}, deaktiviert",
+				ReadOnly:      "{text}, schreibgeschützt",
+			},
+		},
+		"fr": {
+			Tag:              "fr",
+			DecimalSeparator: ",",
+			GroupSeparator:   narrowNbsp,
+			Messages: Messages{
+				Label:         "Curseur",
+				Description:   "{label}" + narrowNbsp + ": {value} sur {max} ({percent})",
+				Value:         "{value}, {spoken_percent}",
+				Focus:         "{description}. Utilisez les flèches pour ajuster.",
+				Minimum:       "Valeur minimale atteinte",
+				Maximum:       "Valeur maximale atteinte",
+				Boundary:      "{announcement}. {boundary}",
+				Progress:      "{spoken_percent} terminé",
+				Complete:      "Terminé",
+				Percent:       "{n}" + narrowNbsp + "%",
+				SpokenPercent: "{n} pour cent",
+				Unit:          "{value} {unit}",
+				Named:         "Curseur {label}",
+				Text:          "{name}, {value} sur {max}, {spoken_percent}, pas de {step}",
+				CompactText:   "{label}" + narrowNbsp + ": {value}/{max}",
+				Busy:          "{name}, en cours",
+				Focused:       "{text}, sélectionné",
+				Described:     "{text}. {description}",
+				ListItem:      "{index}. {text}",
+				Disabled:      "{text}, désactivé",
+				ReadOnly:      "{text}, en lecture seule",
+			},
+			// French uses the singular below two
+			IsOne: func(v float64) bool { return math.Abs(v) < 2 },
+		},
+		"es": {
+			Tag:              "es",
+			DecimalSeparator: ",",
+			GroupSeparator:   ".",
+			Messages: Messages{
+				Label:         "Control deslizante",
+				Description:   "{label}: {value} de {max} ({percent})",
+				Value:         "{value}, {spoken_percent}",
+				Focus:         "{description}. Use las flechas para ajustar.",
+				Minimum:       "Valor mínimo alcanzado",
+				Maximum:       "Valor máximo alcanzado",
+				Boundary:      "{announcement}. {boundary}",
+				Progress:      "{spoken_percent} completado",
+				Complete:      "Completado",
+				Percent:       "{n}" + nbsp + "%",
+				SpokenPercent: "{n} por ciento",
+				Unit:          "{value} {unit}",
+				Named:         "Control deslizante {label}",
+				Text:          "{name}, {value} de {max}, {spoken_percent}, paso {step}",
+				CompactText:   "{label}: {value}/{max}",
+				Busy:          "{name}, en curso",
+				Focused:       "{text}, enfocado",
+				Described:     "{text}. {description}",
+				ListItem:      "{index}. {text}",
+				Disabled:      "{text}, deshabilitado",
+				ReadOnly:      "{text}, solo lectura",
+			},
+		},
+		"ja": {
+			Tag:              "ja",
+			DecimalSeparator: ".",
+			GroupSeparator:   ",",
+			Messages: Messages{
+				Label:         "スライダー",
+				Description:   "{label}：{value} / {max}（{percent}）",
+				Value:         "{value}、{spoken_percent}",
+				Focus:         "{description}。矢印キーで調整します。",
+				Minimum:       "最小値に達しました",
+				Maximum:       "最大値に達しました",
+				Boundary:      "{announcement}。{boundary}",
+				Progress:      "{spoken_percent}完了",
+				Complete:      "完了しました",
+				Percent:       "{n}%",
+				SpokenPercent: "{n}パーセント",
+				Unit:          "{value}{unit}",
+				Named:         "{label}スライダー",
+				Text:          "{name}、{value} / {max}、{spoken_percent}、ステップ {step}",
+				CompactText:   "{label}：{value}/{max}",
+				Busy:          "{name}、処理中",
+				Focused:       "{text}、フォーカス中",
+				Described:     "{text}。{description}",
+				ListItem:      "{index}. {text}",
+				Disabled:      "{text}、無効",
+				ReadOnly:      "{text}、読み取り専用",
+			},
+			IsOne: func(float64) bool { return false },
+		},
+	}
+)
+
+// DefaultLocale returns the English locale, which accessible sliders use
+// unless configured otherwise.
+func DefaultLocale() Locale {
+	l, _ := LookupLocale("en")
+	return l
+}
+
+// LookupLocale returns the locale for a language tag. The full tag is tried
+// first, then its base language, so "pt-BR" finds a registered "pt-BR"
+// locale and "de-AT" falls back to German. Case, encoding and modifiers are
+// ignored, and "_" matches "-": "de_DE.UTF-8" is the same as "de-de".
+func LookupLocale(tag string) (Locale, bool) {
+	tag = localeKey(tag)
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	if l, ok := locales[tag]; ok {
+		return l, true
+	}
+	lang, _, _ := strings.Cut(tag, "-")
+	l, ok := locales[lang]
+	return l, ok
+}
+
+// RegisterLocale adds a locale to the catalog, or replaces the one with the
+// same tag. Tags with a region, such as "pt-BR", are looked up before their
+// base language.
+func RegisterLocale(l Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[localeKey(l.Tag)] = l
+}
+
+// localeKey normalizes a tag for the catalog: lowercase, "-" separated and
+// without an encoding or modifier.
+func localeKey(tag string) string {
+	if i := strings.IndexAny(tag, ".@"); i >= 0 {
+		tag = tag[:i]
+	}
+	return strings.ReplaceAll(strings.ToLower(tag), "_", "-")
+}
+
+// Locales returns the tags in the catalog, sorted.
+func Locales() []string {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// LocaleFromEnv picks the locale from LC_ALL, LC_MESSAGES or LANG, in that
+// order, falling back to DefaultLocale.
+func LocaleFromEnv() Locale {
+	for _, key := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(key); v != "" {
+			if l, ok := LookupLocale(v); ok {
+				return l
+			}
+			break
+		}
+	}
+	return DefaultLocale()
+}
+
+// FormatNumber formats v with the given number of decimals, using the
+// locale's separators. Negative decimals use as many as v needs.
+func (l Locale) FormatNumber(v float64, decimals int) string {
+	s := strconv.FormatFloat(math.Abs(v), 'f', max(decimals, -1), 64)
+	whole, frac, _ := strings.Cut(s, ".")
+
+	if l.GroupSeparator != "" && len(whole) > 3 {
+		var b strings.Builder
+		for i, r := range whole {
+			if i > 0 && (len(whole)-i)%3 == 0 {
+				b.WriteString(l.GroupSeparator)
+			}
+			b.WriteRune(r)
+		}
+		whole = b.String()
+	}
+
+	if frac != "" {
+		whole += l.DecimalSeparator + frac
+	}
+	if v < 0 && strings.Trim(s, "0.") != "" {
+		whole = "-" + whole
+	}
+	return whole
+}
+
+// localizeDecimals replaces the decimal points in formatted, which are
+// points between two digits, with the locale's decimal separator.
+func (l Locale) localizeDecimals(formatted string) string {
+	if l.DecimalSeparator == "." || !strings.Contains(formatted, ".") {
+		return formatted
+	}
+	var b strings.Builder
+	for i := 0; i < len(formatted); i++ {
+		if formatted[i] == '.' && i > 0 && i+1 < len(formatted) &&
+			isDigit(formatted[i-1]) && isDigit(formatted[i+1]) {
+			b.WriteString(l.DecimalSeparator)
+			continue
+		}
+		b.WriteByte(formatted[i])
+	}
+	return b.String()
+}
+
+// isDigit returns true if c is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// Percent formats a fraction from 0 to 1 as a whole percentage.
+func (l Locale) Percent(pct float64) string {
+	return l.Format(l.Messages.Percent, "n", l.FormatNumber(math.Round(pct*100), 0))
+}
+
+// SpokenPercent formats a fraction from 0 to 1 as a whole percentage for
+// speech.
+func (l Locale) SpokenPercent(pct float64) string {
+	return l.Format(l.Messages.SpokenPercent, "n", l.FormatNumber(math.Round(pct*100), 0))
+}
+
+// WithUnit adds a unit name to a formatted value, picking one or other by
+// the locale's plural rule.
+func (l Locale) WithUnit(formatted string, v float64, one, other string) string {
+	unit := other
+	if l.isOne(v) {
+		unit = one
+	}
+	if unit == "" {
+		return formatted
+	}
+	return l.Format(l.Messages.Unit, "value", formatted, "unit", unit)
+}
+
+// Format fills a template's placeholders from name/value pairs.
+func (l Locale) Format(template string, pairs ...string) string {
+	oldnew := make([]string, 0, len(pairs))
+	for i := 0; i+1 < len(pairs); i += 2 {
+		oldnew = append(oldnew, "{"+pairs[i]+"}", pairs[i+1])
+	}
+	return strings.NewReplacer(oldnew...).Replace(template)
+}
+
+// isOne applies the locale's plural rule.
+func (l Locale) isOne(v float64) bool {
+	if l.IsOne != nil {
+		return l.IsOne(v)
+	}
+	return v == 1
+}
@@ -0,0 +1,125 @@
+package tuslide
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocale_FormatNumber(t *testing.T) {
+	en, _ := LookupLocale("en")
+	de, _ := LookupLocale("de")
+	fr, _ := LookupLocale("fr")
+
+	tests := []struct {
+		locale   Locale
+		v        float64
+		decimals int
+		expected string
+	}{
+		{en, 0, 0, "0"},
+		{en, 1234567.891, 2, "1,234,567.89"},
+		{en, -1234.5, 1, "-1,234.5"},
+		{en, -0.01, 0, "0"},
+		{en, 999, 0, "999"},
+		{de, 1234.5, 1, "1.234,5"},
+		{fr, 1234.5, 1, "1\u202f234,5"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.locale.FormatNumber(tt.v, tt.decimals); got != tt.expected {
+			t.Errorf("%s FormatNumber(%v, %d) = %q, expected %q",
+				tt.locale.Tag, tt.v, tt.decimals, got, tt.expected)
+		}
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	for _, tag := range []string{"de", "de-AT", "de_DE.UTF-8", "DE"} {
+		if l, ok := LookupLocale(tag); !ok || l.Tag != "de" {
+			t.Errorf("Expected %q to find German", tag)
+		}
+	}
+	if _, ok := LookupLocale("xx"); ok {
+		t.Error("Unknown languages should not be found")
+	}
+
+	for _, tag := range []string{"de", "en", "es", "fr", "ja"} {
+		l, _ := LookupLocale(tag)
+		m := reflect.ValueOf(l.Messages)
+		for i := 0; i < m.NumField(); i++ {
+			if m.Field(i).String() == "" {
+				t.Errorf("%s: missing %s message", tag, m.Type().Field(i).Name)
+			}
+		}
+	}
+}
+
+func TestLocaleFromEnv(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "fr_FR.UTF-8")
+	t.Setenv("LANG", "de_DE.UTF-8")
+	if l := LocaleFromEnv(); l.Tag != "fr" {
+		t.Errorf("LC_MESSAGES should win over LANG, got %s", l.Tag)
+	}
+
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "C")
+	if l := LocaleFromEnv(); l.Tag != "en" {
+		t.Errorf("Unknown locales should fall back to English, got %s", l.Tag)
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	nl := DefaultLocale()
+	nl.Tag = "nl"
+	nl.Messages.Complete = "Voltooid"
+	RegisterLocale(nl)
+
+	if l, ok := LookupLocale("nl-BE"); !ok || l.Messages.Complete != "Voltooid" {
+		t.Error("Registered locale should be found")
+	}
+	if en, _ := LookupLocale("en"); en.Messages.Complete != "Complete" {
+		t.Error("Registering a copy should not change the original")
+	}
+}
+
+func TestRegisterLocale_Region(t *testing.T) {
+	pt := DefaultLocale()
+	pt.Tag = "pt"
+	pt.Messages.Complete = "Concluído"
+	RegisterLocale(pt)
+
+	br := pt
+	br.Tag = "pt_BR"
+	br.Messages.Complete = "Completo"
+	RegisterLocale(br)
+
+	for _, tag := range []string{"pt-BR", "pt_br", "PT-BR", "pt_BR.UTF-8", "pt_BR@euro"} {
+		if l, ok := LookupLocale(tag); !ok || l.Messages.Complete != "Completo" {
+			t.Errorf("Expected %q to find Brazilian Portuguese", tag)
+		}
+	}
+	if l, ok := LookupLocale("pt-PT"); !ok || l.Messages.Complete != "Concluído" {
+		t.Error("Other regions should fall back to the base language")
+	}
+
+	t.Setenv("LC_ALL", "pt_BR.UTF-8")
+	if l := LocaleFromEnv(); l.Tag != "pt_BR" {
+		t.Errorf("Expected the regional locale from the environment, got %s", l.Tag)
+	}
+}
+
+func TestLocale_WithUnit(t *testing.T) {
+	en, _ := LookupLocale("en")
+	fr, _ := LookupLocale("fr")
+
+	if got := en.WithUnit("1", 1, "step", "steps"); got != "1 step" {
+		t.Errorf("Expected singular, got %q", got)
+	}
+	if got := en.WithUnit("1.5", 1.5, "step", "steps"); got != "1.5 steps" {
+		t.Errorf("Expected plural, got %q", got)
+	}
+	if got := fr.WithUnit("1,5", 1.5, "pas", "pas"); got != "1,5 pas" {
+		t.Errorf("Expected French singular, got %q", got)
+	}
+}
@@ -0,0 +1,190 @@
+package tuslide
+
+import (
+	"math"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MarqueeMode defines how a Marquee's block travels the track.
+type MarqueeMode int
+
+const (
+	// MarqueeBounce moves the block back and forth between the ends.
+	MarqueeBounce MarqueeMode = iota
+	// MarqueeLoop slides the block in at the start and out at the end.
+	MarqueeLoop
+)
+
+// Default Marquee settings.
+const (
+	DefaultMarqueeWidth  = 0.25
+	DefaultMarqueePeriod = 2 * time.Second
+)
+
+// Marquee animates a slider in indeterminate mode: a block travels the
+// track to show that work is happening while the total is unknown. Call
+// Settle once the total is known to morph the block into the fill for the
+// state's value. Under reduced motion the block stays centered.
+type Marquee struct {
+	id     int
+	slider *Slider
+	width  float64
+	period time.Duration
+	mode   MarqueeMode
+
+	// Settling into determinate mode
+	settling    bool
+	settleFrom  [2]float64
+	settleStart time.Duration
+	settleFor   time.Duration
+
+	playhead
+	motionSetting
+}
+
+// NewMarquee creates a marquee for slider and switches the slider to
+// indeterminate mode.
+func NewMarquee(slider *Slider) *Marquee {
+	slider.SetIndeterminate(true)
+	return &Marquee{
+		id:            NewAnimationID(),
+		slider:        slider,
+		width:         DefaultMarqueeWidth,
+		period:        DefaultMarqueePeriod,
+		playhead:      newPlayhead(),
+		motionSetting: motionFor(slider.state),
+	}
+}
+
+// WithWidth sets the width of the block as a fraction of the track.
+func (m *Marquee) WithWidth(width float64) *Marquee {
+	m.width = Clamp(width, 0, 1)
+	return m
+}
+
+// WithPeriod sets how long one pass takes: there and back for
+// MarqueeBounce, start to end for MarqueeLoop.
+func (m *Marquee) WithPeriod(period time.Duration) *Marquee {
+	if period > 0 {
+		m.period = period
+	}
+	return m
+}
+
+// WithMode sets how the block travels.
+func (m *Marquee) WithMode(mode MarqueeMode) *Marquee {
+	m.mode = mode
+	return m
+}
+
+// WithClock sets the clock the marquee reads time from and restarts it.
+func (m *Marquee) WithClock(c Clock) *Marquee {
+	m.setClock(c)
+	return m
+}
+
+// WithMotion sets the marquee's motion preference.
+func (m *Marquee) WithMotion(p MotionPreference) *Marquee {
+	m.motion = p
+	return m
+}
+
+// ID returns the marquee's program-wide unique ID.
+func (m *Marquee) ID() int {
+	return m.id
+}
+
+// State returns nil: the marquee ignores its slider's value until it
+// settles, so tweens of the state aren't replaced.
+func (m *Marquee) State() *SliderState {
+	return nil
+}
+
+// effect keys marquees on their slider.
+func (m *Marquee) effect() effectKey {
+	return effectKey{target: m.slider, kind: "marquee"}
+}
+
+// Settle switches the slider to determinate mode, morphing the block into
+// the fill for the state's value over duration. The marquee completes
+// once it has settled. Under reduced motion the switch is immediate.
+func (m *Marquee) Settle(duration time.Duration) {
+	if m.settling {
+		return
+	}
+	if m.effectiveMotion() != MotionFull {
+		m.slider.SetIndeterminate(false)
+		return
+	}
+	start, end := m.slider.BusySpan()
+	m.settling = true
+	m.settleFrom = [2]float64{start, end}
+	m.settleStart = m.elapsed()
+	m.settleFor = duration
+}
+
+// Update moves the block. Returns true once the marquee has settled or
+// the slider has left indeterminate mode. Under reduced motion it centers
+// the block and returns true at once, as a still block needs no frames.
+func (m *Marquee) Update() bool {
+	if !m.slider.IsIndeterminate() {
+		return true
+	}
+	if m.paused {
+		return false
+	}
+
+	if m.settling {
+		return m.settle()
+	}
+
+	if m.effectiveMotion() != MotionFull {
+		m.slider.SetBusySpan(0.5-m.width/2, 0.5+m.width/2)
+		return true
+	}
+
+	phase := float64(m.elapsed()%m.period) / float64(m.period)
+
+	var start float64
+	switch m.mode {
+	case MarqueeLoop:
+		start = -m.width + phase*(1+m.width)
+	default:
+		// Triangle wave: out to the far end and back in one period
+		start = (1 - math.Abs(2*phase-1)) * (1 - m.width)
+	}
+	m.slider.SetBusySpan(start, start+m.width)
+
+	return false
+}
+
+// settle moves the block toward the fill and leaves indeterminate mode
+// once it gets there. Returns true when done.
+func (m *Marquee) settle() bool {
+	duration, easing := motionTiming(m.effectiveMotion(), m.settleFor, EaseInOutQuad)
+	elapsed := m.elapsed() - m.settleStart
+
+	if elapsed >= duration {
+		m.slider.SetIndeterminate(false)
+		return true
+	}
+
+	t := easing(float64(elapsed) / float64(duration))
+	pct := m.slider.State().Percentage()
+	m.slider.SetBusySpan(Lerp(m.settleFrom[0], 0, t), Lerp(m.settleFrom[1], pct, t))
+	return false
+}
+
+// Tick returns a command for the next marquee frame.
+func (m *Marquee) Tick() tea.Cmd {
+	return animatorTick(m.id, DefaultFPS)
+}
+
+// HandleTick advances the marquee if msg is a tick addressed to it and
+// returns the command for the next frame, or an AnimationCompleteMsg once
+// it settles.
+func (m *Marquee) HandleTick(msg tea.Msg) tea.Cmd {
+	return handleAnimatorTick(m, msg, DefaultFPS)
+}
@@ -0,0 +1,179 @@
+package tuslide
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSlider_IndeterminateRendering(t *testing.T) {
+	state := NewState(WithValue(90), WithMax(100))
+	slider := New(state, WithWidth(10), WithIndeterminate(true), WithShowValue(true))
+	slider.SetBusySpan(0.2, 0.5)
+
+	view := slider.View()
+	if !strings.HasPrefix(view, "░░███░░░░░") {
+		t.Errorf("Expected busy span at cells 2-4, got %q", view)
+	}
+	if strings.Contains(view, "●") {
+		t.Error("Indeterminate mode should not draw a handle")
+	}
+	if !strings.Contains(view, "--") || strings.Contains(view, "90") {
+		t.Errorf("Indeterminate mode should not show the value, got %q", view)
+	}
+	if slider.HandleRect() != (Rect{}) {
+		t.Error("Indeterminate mode should have no handle rect")
+	}
+
+	// Spans partly off the track are clipped
+	slider.SetBusySpan(-0.1, 0.2)
+	if view := slider.View(); !strings.HasPrefix(view, "██░░░░░░░░") {
+		t.Errorf("Expected clipped span, got %q", view)
+	}
+}
+
+func TestSlider_IndeterminateSegmentedAndVertical(t *testing.T) {
+	segmented := New(NewState(), WithSegmented(true), WithSegmentCount(4), WithSegmentGap(0),
+		WithIndeterminate(true))
+	segmented.SetBusySpan(0.5, 0.75)
+	if view := segmented.View(); view != "░░█░" {
+		t.Errorf("Expected third segment busy, got %q", view)
+	}
+
+	vertical := New(NewState(), WithOrientation(Vertical), WithHeight(4), WithIndeterminate(true))
+	vertical.SetBusySpan(0, 0.5)
+	if view := vertical.View(); view != "░\n░\n█\n█" {
+		t.Errorf("Expected bottom half busy, got %q", view)
+	}
+}
+
+func TestMarquee_Bounce(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	slider := New(NewState())
+	marquee := NewMarquee(slider).WithWidth(0.2).WithPeriod(time.Second).
+		WithClock(clock).WithMotion(MotionFull)
+
+	if !slider.IsIndeterminate() {
+		t.Fatal("NewMarquee should switch the slider to indeterminate mode")
+	}
+
+	tests := []struct {
+		at    time.Duration
+		start float64
+	}{
+		{0, 0},
+		{250 * time.Millisecond, 0.4},
+		{500 * time.Millisecond, 0.8},
+		{750 * time.Millisecond, 0.4},
+		{time.Second, 0},
+	}
+
+	var last time.Duration
+	for _, tt := range tests {
+		clock.Advance(tt.at - last)
+		last = tt.at
+		if marquee.Update() {
+			t.Fatal("Marquee should run until settled")
+		}
+		start, end := slider.BusySpan()
+		if math.Abs(start-tt.start) > 1e-9 || math.Abs(end-start-0.2) > 1e-9 {
+			t.Errorf("At %v: expected span from %f, got %f-%f", tt.at, tt.start, start, end)
+		}
+	}
+}
+
+func TestMarquee_Loop(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	slider := New(NewState())
+	marquee := NewMarquee(slider).WithWidth(0.25).WithPeriod(time.Second).
+		WithMode(MarqueeLoop).WithClock(clock).WithMotion(MotionFull)
+
+	marquee.Update()
+	if start, end := slider.BusySpan(); start != -0.25 || end != 0 {
+		t.Errorf("Loop should start off the track, got %f-%f", start, end)
+	}
+
+	clock.Advance(800 * time.Millisecond)
+	marquee.Update()
+	if start, _ := slider.BusySpan(); start != 0.75 {
+		t.Errorf("Expected block at the end, got %f", start)
+	}
+}
+
+func TestMarquee_Settle(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	state := NewState(WithValue(60), WithMax(100))
+	slider := New(state, WithWidth(10))
+	marquee := NewMarquee(slider).WithWidth(0.2).WithPeriod(time.Second).
+		WithClock(clock).WithMotion(MotionFull)
+
+	clock.Advance(500 * time.Millisecond)
+	marquee.Update()
+
+	marquee.Settle(100 * time.Millisecond)
+	clock.Advance(50 * time.Millisecond)
+	if marquee.Update() {
+		t.Error("Marquee should not be settled half-way")
+	}
+	start, end := slider.BusySpan()
+	if !(start > 0 && start < 0.8) || !(end > 0.6 && end < 1) {
+		t.Errorf("Expected span between block and fill, got %f-%f", start, end)
+	}
+
+	clock.Advance(50 * time.Millisecond)
+	if !marquee.Update() {
+		t.Error("Marquee should complete once settled")
+	}
+	if slider.IsIndeterminate() {
+		t.Error("Settled slider should be determinate")
+	}
+	if !strings.Contains(slider.View(), "●") {
+		t.Error("Determinate slider should draw its handle again")
+	}
+}
+
+func TestMarquee_ReplacesItself(t *testing.T) {
+	state := NewState(WithValue(60), WithMax(100))
+	slider := New(state, WithWidth(10))
+	manager := NewAnimationManager()
+
+	tween := manager.Start(state, 100, WithAnimDuration(time.Second))
+	first := manager.Run(NewMarquee(slider))
+	manager.Run(NewMarquee(New(state, WithWidth(10))))
+	manager.Run(NewMarquee(slider))
+	if _, ok := manager.Get(first); ok || manager.Count() != 3 {
+		t.Errorf("Expected a second marquee on the slider to replace the first, got %d animations", manager.Count())
+	}
+	if _, ok := manager.Get(tween); !ok {
+		t.Error("Marquees should leave the value tween alone")
+	}
+}
+
+func TestMarquee_ReducedMotion(t *testing.T) {
+	slider := New(NewState())
+	marquee := NewMarquee(slider).WithWidth(0.2).WithMotion(MotionReduced)
+
+	if !marquee.Update() {
+		t.Error("A still block needs no frames, so the marquee should complete")
+	}
+	if start, end := slider.BusySpan(); math.Abs(start-0.4) > 1e-9 || math.Abs(end-0.6) > 1e-9 {
+		t.Errorf("Reduced-motion marquee should stay centered, got %f-%f", start, end)
+	}
+	if !slider.IsIndeterminate() {
+		t.Error("The slider should stay busy until settled")
+	}
+
+	// Settling switches straight to determinate mode, without frames
+	marquee.Settle(300 * time.Millisecond)
+	if slider.IsIndeterminate() {
+		t.Error("Reduced-motion settle should be immediate")
+	}
+
+	// Switching off indeterminate mode ends the marquee
+	full := NewMarquee(slider).WithMotion(MotionFull)
+	slider.SetIndeterminate(false)
+	if !full.Update() {
+		t.Error("Marquee should complete when the slider leaves indeterminate mode")
+	}
+}
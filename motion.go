@@ -0,0 +1,134 @@
+package tuslide
+
+import (
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// MotionPreference controls how much animations move, for users who get
+// motion sickness from bouncing, elastic or oscillating effects.
+type MotionPreference int
+
+const (
+	// MotionDefault inherits the preference: animators follow the state
+	// they animate, then their AnimationManager, and managers follow the
+	// global preference.
+	MotionDefault MotionPreference = iota
+	// MotionFull plays animations as configured.
+	MotionFull
+	// MotionReduced replaces animations with a short linear fade to their
+	// end state (see ReducedMotionFade).
+	MotionReduced
+	// MotionNone jumps straight to the end state.
+	MotionNone
+)
+
+// ReducedMotionEnv is the environment variable that sets the initial global
+// preference: "1", "true", "yes", "on", "reduce" or "fade" select
+// MotionReduced, "jump" or "none" select MotionNone.
+const ReducedMotionEnv = "TUSLIDE_REDUCED_MOTION"
+
+// ReducedMotionFade is the length of the linear fade used under
+// MotionReduced. Animations that are already shorter keep their duration.
+const ReducedMotionFade = 150 * time.Millisecond
+
+// globalMotion holds the global MotionPreference.
+var globalMotion atomic.Int32
+
+func init() {
+	globalMotion.Store(int32(MotionFromEnv()))
+}
+
+// MotionFromEnv returns the preference requested by ReducedMotionEnv, or
+// MotionFull if it is unset or unrecognized.
+func MotionFromEnv() MotionPreference {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(ReducedMotionEnv))) {
+	case "1", "true", "yes", "on", "reduce", "reduced", "fade":
+		return MotionReduced
+	case "jump", "none":
+		return MotionNone
+	}
+	return MotionFull
+}
+
+// SetMotionPreference sets the global motion preference used by every
+// animator and manager that doesn't set its own. MotionDefault restores
+// the preference from the environment.
+func SetMotionPreference(p MotionPreference) {
+	if p == MotionDefault {
+		p = MotionFromEnv()
+	}
+	globalMotion.Store(int32(p))
+}
+
+// GlobalMotionPreference returns the global motion preference.
+func GlobalMotionPreference() MotionPreference {
+	return MotionPreference(globalMotion.Load())
+}
+
+// ReducedMotion reports whether the global preference reduces motion.
+func ReducedMotion() bool {
+	return GlobalMotionPreference() != MotionFull
+}
+
+// motionSetting is embedded by animators to resolve their effective
+// motion preference.
+type motionSetting struct {
+	motion    MotionPreference // Set on the animator itself
+	states    []*SliderState   // States whose preference applies
+	inherited MotionPreference // Set by the AnimationManager running it
+}
+
+// motionFor returns the setting of an animator of states.
+func motionFor(states ...*SliderState) motionSetting {
+	return motionSetting{states: states}
+}
+
+// effectiveMotion resolves the animator's preference, falling back to its
+// states', then the manager's and then the global one. When states
+// disagree, the one that reduces motion most wins.
+func (s *motionSetting) effectiveMotion() MotionPreference {
+	if s.motion != MotionDefault {
+		return s.motion
+	}
+
+	var fromStates MotionPreference
+	for _, state := range s.states {
+		if state != nil {
+			fromStates = max(fromStates, state.motion)
+		}
+	}
+
+	switch {
+	case fromStates != MotionDefault:
+		return fromStates
+	case s.inherited != MotionDefault:
+		return s.inherited
+	}
+	return GlobalMotionPreference()
+}
+
+// inheritMotion records the preference of the manager running the animator.
+func (s *motionSetting) inheritMotion(p MotionPreference) {
+	s.inherited = p
+}
+
+// motionInheritor is implemented by animators that follow the motion
+// preference of the AnimationManager running them.
+type motionInheritor interface {
+	inheritMotion(MotionPreference)
+}
+
+// motionTiming returns the duration and easing a tween of duration d should
+// use under preference p.
+func motionTiming(p MotionPreference, d time.Duration, easing EasingFunc) (time.Duration, EasingFunc) {
+	switch p {
+	case MotionNone:
+		return 0, Linear
+	case MotionReduced:
+		return min(d, ReducedMotionFade), Linear
+	}
+	return d, easing
+}
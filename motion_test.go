@@ -0,0 +1,213 @@
+package tuslide
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMotionFromEnv(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected MotionPreference
+	}{
+		{"", MotionFull},
+		{"0", MotionFull},
+		{"1", MotionReduced},
+		{" TRUE ", MotionReduced},
+		{"reduce", MotionReduced},
+		{"jump", MotionNone},
+		{"none", MotionNone},
+		{"sideways", MotionFull},
+	}
+
+	for _, tt := range tests {
+		t.Setenv(ReducedMotionEnv, tt.value)
+		if got := MotionFromEnv(); got != tt.expected {
+			t.Errorf("%q: expected %d, got %d", tt.value, tt.expected, got)
+		}
+	}
+}
+
+// setMotion sets the global motion preference until the test ends.
+func setMotion(t *testing.T, p MotionPreference) {
+	t.Helper()
+	prev := GlobalMotionPreference()
+	SetMotionPreference(p)
+	t.Cleanup(func() { SetMotionPreference(prev) })
+}
+
+func TestSetMotionPreference(t *testing.T) {
+	setMotion(t, MotionNone)
+	if GlobalMotionPreference() != MotionNone || !ReducedMotion() {
+		t.Error("Expected global preference MotionNone")
+	}
+
+	// MotionDefault goes back to the environment
+	t.Setenv(ReducedMotionEnv, "1")
+	SetMotionPreference(MotionDefault)
+	if GlobalMotionPreference() != MotionReduced {
+		t.Errorf("Expected MotionReduced from env, got %d", GlobalMotionPreference())
+	}
+}
+
+func TestMotion_Precedence(t *testing.T) {
+	setMotion(t, MotionNone)
+
+	clock := NewManualClock(time.Time{})
+	manager := NewAnimationManager(WithClock(clock))
+	if manager.MotionPreference() != MotionNone {
+		t.Error("Manager should follow the global preference by default")
+	}
+
+	// Manager overrides global
+	manager.SetMotionPreference(MotionFull)
+	state := NewState(WithValue(0), WithMax(100))
+	manager.Start(state, 100, WithAnimDuration(100*time.Millisecond), WithEasing(Linear))
+	clock.Advance(50 * time.Millisecond)
+	manager.Update()
+	if state.Value() != 50 {
+		t.Errorf("Expected full motion from manager, got %f", state.Value())
+	}
+
+	// Animator overrides manager
+	other := NewState(WithValue(0), WithMax(100))
+	manager.Start(other, 100, WithAnimDuration(time.Second), WithAnimMotion(MotionNone))
+	manager.Update()
+	if other.Value() != 100 {
+		t.Errorf("Expected animator's MotionNone to jump, got %f", other.Value())
+	}
+
+	// State overrides manager
+	quiet := NewState(WithValue(0), WithMax(100))
+	quiet.SetMotionPreference(MotionNone)
+	manager.Start(quiet, 100, WithAnimDuration(time.Second))
+	manager.Update()
+	if quiet.Value() != 100 {
+		t.Errorf("Expected the state's MotionNone to jump, got %f", quiet.Value())
+	}
+
+	// Changing the manager updates running animations
+	manager.SetMotionPreference(MotionNone)
+	manager.Update()
+	if state.Value() != 100 || manager.IsRunning() {
+		t.Errorf("Expected running animation to jump to 100, got %f", state.Value())
+	}
+}
+
+func TestAnimation_ReducedMotion(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	state := NewState(WithValue(0), WithMax(100))
+	anim := NewAnimation(state, 100,
+		WithAnimDuration(time.Second),
+		WithEasing(EaseOutElastic),
+		WithAnimClock(clock),
+		WithAnimMotion(MotionReduced),
+	)
+
+	// Linear fade over ReducedMotionFade instead of the elastic second
+	clock.Advance(ReducedMotionFade / 2)
+	if anim.Update() || math.Abs(state.Value()-50) > 0.001 {
+		t.Errorf("Expected linear fade at 50, got %f", state.Value())
+	}
+
+	clock.Advance(ReducedMotionFade / 2)
+	if !anim.IsComplete() || !anim.Update() || state.Value() != 100 {
+		t.Errorf("Expected fade to finish at 100, got %f", state.Value())
+	}
+
+	// Short animations keep their duration
+	short := NewAnimation(state, 0,
+		WithAnimDuration(20*time.Millisecond),
+		WithAnimClock(clock),
+		WithAnimMotion(MotionReduced),
+	)
+	clock.Advance(20 * time.Millisecond)
+	if !short.Update() {
+		t.Error("Short animation should not be lengthened")
+	}
+}
+
+func TestPulseAnimation_ReducedMotion(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	state := NewState(WithValue(50), WithMax(100))
+	pulse := NewPulseAnimation(state, 20, 2, time.Second).WithClock(clock).WithMotion(MotionReduced)
+
+	clock.Advance(250 * time.Millisecond)
+	if !pulse.Update() {
+		t.Error("Pulse should finish immediately under reduced motion")
+	}
+	if state.Value() != 50 {
+		t.Errorf("Pulse should rest at its base value, got %f", state.Value())
+	}
+}
+
+func TestSpringAnimation_ReducedMotion(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	state := NewState(WithValue(0), WithMax(100))
+	spring := NewSpringAnimation(state, 100).WithConfig(SpringWobbly).WithClock(clock).WithMotion(MotionReduced)
+
+	peak := 0.0
+	for i := 0; i < 20; i++ {
+		clock.Advance(10 * time.Millisecond)
+		done := spring.Update()
+		peak = math.Max(peak, state.Value())
+		if done {
+			break
+		}
+	}
+	if peak > 100 {
+		t.Errorf("Reduced-motion spring should not overshoot, peaked at %f", peak)
+	}
+	if state.Value() != 100 {
+		t.Errorf("Expected spring at 100 after the fade, got %f", state.Value())
+	}
+
+	// Retargeting restarts the fade from the current value
+	spring.SetTarget(0)
+	spring.Update()
+	clock.Advance(ReducedMotionFade / 2)
+	if spring.Update() || math.Abs(state.Value()-50) > 0.001 {
+		t.Errorf("Expected retargeted fade at 50, got %f", state.Value())
+	}
+	clock.Advance(ReducedMotionFade / 2)
+	if !spring.Update() || state.Value() != 0 {
+		t.Errorf("Expected spring at 0 after the fade, got %f", state.Value())
+	}
+
+	jump := NewSpringAnimation(NewState(WithValue(0), WithMax(100)), 80).WithClock(clock).WithMotion(MotionNone)
+	if !jump.Update() || jump.State().Value() != 80 {
+		t.Errorf("MotionNone spring should jump to its target, got %f", jump.State().Value())
+	}
+}
+
+func TestTimeline_ReducedMotion(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	state := NewState(WithValue(0), WithMax(100))
+	clip := Keyframes(state, Key(0, 0), Key(100, time.Second))
+
+	tl := NewTimeline(clip, WithIterations(2), WithYoyo(),
+		WithTimelineClock(clock), WithTimelineMotion(MotionNone))
+	state.SetValue(50)
+	if !tl.Update() {
+		t.Error("Timeline should finish immediately without motion")
+	}
+	if state.Value() != 0 {
+		t.Errorf("Yoyo timeline should end at its start value, got %f", state.Value())
+	}
+}
+
+func TestTimeline_StateMotion(t *testing.T) {
+	a := NewState(WithValue(0), WithMax(100))
+	b := NewState(WithValue(0), WithMax(100))
+	b.SetMotionPreference(MotionReduced)
+
+	// The state that reduces motion most decides for the whole timeline
+	tl := NewTimeline(Parallel(
+		Keyframes(a, Key(100, time.Second)),
+		Keyframes(b, Key(100, time.Second)),
+	))
+	if !tl.Update() || a.Value() != 100 || b.Value() != 100 {
+		t.Errorf("Expected the timeline to jump to its end, got a=%f b=%f", a.Value(), b.Value())
+	}
+}
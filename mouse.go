@@ -1,9 +1,26 @@
 package tuslide
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/charmbracelet/bubbletea"
 )
 
+// DragMode defines how pressing and dragging changes the slider value.
+type DragMode int
+
+const (
+	// DragJump moves the value to the pointer on press and follows it while dragging (default).
+	DragJump DragMode = iota
+	// DragHandle only starts a drag when the press lands on the handle.
+	// The handle then follows the pointer, keeping the grab offset.
+	DragHandle
+	// DragRelative adjusts the value by pointer motion from where the drag
+	// started, so pressing never moves the value by itself.
+	DragRelative
+)
+
 // MouseState tracks mouse interaction state for a slider.
 type MouseState struct {
 	// Bounding box of the slider track (for hit testing)
@@ -13,11 +30,54 @@ type MouseState struct {
 	// Interaction state
 	Dragging bool
 	Focused  bool
+
+	// Wheel behavior
+	WheelStep   int  // Steps applied per wheel notch (values below 1 count as 1)
+	InvertWheel bool // Reverse the wheel direction
+
+	// Drag behavior
+	Mode             DragMode
+	PageOnTrackClick bool    // Clicks on the track away from the handle page toward the pointer
+	PageStep         int     // Steps applied per page click (values below 1 count as 1)
+	PrecisionFactor  float64 // Motion scale in DragRelative mode while Shift or Alt is held (0 disables)
+
+	// Hover state (requires EnableMouseAllMotion for motion without buttons)
+	Hovered    bool
+	HoverValue float64 // Value under the pointer while hovered
+
+	// Clock used to time drags for ReleaseVelocity (nil uses RealClock)
+	Clock Clock
+
+	index        int       // Position within a SliderGroup, -1 when standalone
+	pendingHover []tea.Msg // Enter/leave messages waiting for HoverCmd
+
+	// Drag bookkeeping
+	grabOffset int     // Pointer offset from the handle anchor (DragHandle)
+	lastPos    int     // Last pointer position along the track axis (DragRelative)
+	dragValue  float64 // Unrounded value accumulated while dragging (DragRelative)
+
+	samples         []dragSample // Recent drag values for the release velocity
+	releaseVelocity float64
 }
 
+// dragSample is a slider value seen while dragging.
+type dragSample struct {
+	at    time.Time
+	value float64
+}
+
+// velocityWindow is how far back drag samples count toward the release
+// velocity, so stopping before letting go doesn't fling.
+const velocityWindow = 100 * time.Millisecond
+
 // NewMouseState creates a new mouse state.
 func NewMouseState() *MouseState {
-	return &MouseState{}
+	return &MouseState{
+		index:           -1,
+		WheelStep:       1,
+		PageStep:        1,
+		PrecisionFactor: 0.1,
+	}
 }
 
 // SetBounds sets the bounding box for mouse hit testing.
@@ -29,6 +89,17 @@ func (m *MouseState) SetBounds(x, y, width, height int) {
 	m.Height = height
 }
 
+// SetTrackBounds sets the bounds to the slider's track, given the screen
+// position where the slider's view was rendered.
+// Use a ZoneManager to discover that position from the composed view.
+func (m *MouseState) SetTrackBounds(originX, originY int, slider *Slider) {
+	if slider == nil {
+		return
+	}
+	r := slider.TrackRect()
+	m.SetBounds(originX+r.X, originY+r.Y, r.Width, r.Height)
+}
+
 // Contains checks if a point is within the slider bounds.
 func (m *MouseState) Contains(x, y int) bool {
 	return x >= m.X && x < m.X+m.Width &&
@@ -37,30 +108,62 @@ func (m *MouseState) Contains(x, y int) bool {
 
 // HandleMouse processes a mouse event and returns true if the slider was interacted with.
 // It also updates the slider state value based on click/drag position.
+// Disabled sliders ignore the mouse; read-only sliders take focus on a
+// click but keep their value.
 func (m *MouseState) HandleMouse(msg tea.MouseMsg, slider *Slider) bool {
 	if slider == nil || slider.state == nil {
 		return false
 	}
 
+	m.updateHover(msg, slider)
+	return m.handle(msg, slider)
+}
+
+// handle is HandleMouse after the hover state was updated.
+func (m *MouseState) handle(msg tea.MouseMsg, slider *Slider) bool {
+	if slider == nil || slider.state == nil {
+		return false
+	}
+
+	m.releaseVelocity = 0
+
+	if !slider.Editable() {
+		m.Dragging = false
+		m.samples = m.samples[:0]
+		if !slider.disabled && msg.Action == tea.MouseActionPress &&
+			msg.Button == tea.MouseButtonLeft && m.Contains(msg.X, msg.Y) {
+			m.Focused = true
+			return true
+		}
+		return false
+	}
+
 	switch msg.Action {
 	case tea.MouseActionPress:
+		if tea.MouseEvent(msg).IsWheel() {
+			if m.Contains(msg.X, msg.Y) {
+				return m.handleWheel(msg, slider)
+			}
+			return false
+		}
 		if msg.Button == tea.MouseButtonLeft && m.Contains(msg.X, msg.Y) {
-			m.Dragging = true
 			m.Focused = true
-			m.updateValue(msg.X, msg.Y, slider)
+			m.press(msg, slider)
 			return true
 		}
 
 	case tea.MouseActionMotion:
 		if m.Dragging {
-			m.updateValue(msg.X, msg.Y, slider)
+			m.drag(msg, slider)
 			return true
 		}
 
 	case tea.MouseActionRelease:
 		if m.Dragging {
 			m.Dragging = false
-			m.updateValue(msg.X, msg.Y, slider)
+			m.drag(msg, slider)
+			m.releaseVelocity = m.dragVelocity()
+			m.samples = m.samples[:0]
 			return true
 		}
 	}
@@ -68,50 +171,290 @@ func (m *MouseState) HandleMouse(msg tea.MouseMsg, slider *Slider) bool {
 	return false
 }
 
-// updateValue updates the slider value based on mouse position.
-func (m *MouseState) updateValue(mouseX, mouseY int, slider *Slider) {
-	var percentage float64
-
-	switch slider.orientation {
-	case Horizontal:
-		// Calculate percentage based on X position within track
-		if m.Width > 0 {
-			relX := mouseX - m.X
-			if relX < 0 {
-				relX = 0
-			}
-			if relX > m.Width {
-				relX = m.Width
-			}
-			percentage = float64(relX) / float64(m.Width)
+// press starts a drag or pages the value, depending on the drag mode and
+// whether the press landed on the handle.
+func (m *MouseState) press(msg tea.MouseMsg, slider *Slider) {
+	pos := axisPosition(msg.X, msg.Y, slider.orientation)
+	start, length := m.handleSpan(slider)
+	onHandle := length > 0 && pos >= start && pos < start+length
+
+	// Without a visible handle (progress bar mode) every press counts as
+	// being on the handle, so paging and grabbing don't apply.
+	if length > 0 && !onHandle && (m.PageOnTrackClick || m.Mode == DragHandle) {
+		if m.PageOnTrackClick {
+			m.page(pos, start+length/2, slider)
 		}
+		return
+	}
 
-	case Vertical:
-		// Calculate percentage based on Y position within track
-		// Note: Y increases downward, but slider fills from bottom
-		if m.Height > 0 {
-			relY := mouseY - m.Y
-			if relY < 0 {
-				relY = 0
-			}
-			if relY > m.Height {
-				relY = m.Height
-			}
-			// Invert because Y=0 is top, but 100% should be at top
-			percentage = 1.0 - (float64(relY) / float64(m.Height))
+	m.Dragging = true
+	m.grabOffset = 0
+	switch {
+	case m.Mode == DragRelative:
+		m.lastPos = pos
+		m.dragValue = slider.state.Value()
+	case m.Mode == DragHandle && length > 0:
+		m.grabOffset = pos - (start + length/2)
+	default:
+		m.updateValue(msg.X, msg.Y, slider)
+	}
+
+	m.samples = m.samples[:0]
+	m.recordSample(slider.state.Value())
+}
+
+// drag applies pointer motion while dragging.
+func (m *MouseState) drag(msg tea.MouseMsg, slider *Slider) {
+	defer func() { m.recordSample(slider.state.Value()) }()
+
+	switch m.Mode {
+	case DragRelative:
+		pos := axisPosition(msg.X, msg.Y, slider.orientation)
+		delta := pos - m.lastPos
+		m.lastPos = pos
+		if delta == 0 {
+			return
+		}
+
+		length := m.Width
+		if slider.orientation == Vertical {
+			// Screen rows grow downward, but values grow upward.
+			delta = -delta
+			length = m.Height
+		}
+		if length <= 0 {
+			return
+		}
+
+		scale := 1.0
+		if (msg.Shift || msg.Alt) && m.PrecisionFactor > 0 {
+			scale = m.PrecisionFactor
+		}
+
+		state := slider.state
+		m.dragValue += float64(delta) / float64(length) * state.Range() * scale
+		m.dragValue = Clamp(m.dragValue, state.Min(), state.Max())
+		state.SetValue(m.dragValue)
+
+	default:
+		x, y := msg.X, msg.Y
+		if slider.orientation == Vertical {
+			y -= m.grabOffset
+		} else {
+			x -= m.grabOffset
+		}
+		m.updateValue(x, y, slider)
+	}
+}
+
+// recordSample remembers the value at the current time, forgetting
+// samples that fell out of the velocity window.
+func (m *MouseState) recordSample(value float64) {
+	now := clockOrReal(m.Clock).Now()
+	m.samples = append(m.samples, dragSample{at: now, value: value})
+
+	stale := 0
+	for stale < len(m.samples)-1 && now.Sub(m.samples[stale].at) > velocityWindow {
+		stale++
+	}
+	m.samples = m.samples[stale:]
+}
+
+// dragVelocity returns the value velocity over the recorded samples.
+func (m *MouseState) dragVelocity() float64 {
+	if len(m.samples) < 2 {
+		return 0
+	}
+
+	first, last := m.samples[0], m.samples[len(m.samples)-1]
+	dt := last.at.Sub(first.at).Seconds()
+	if dt <= 0 {
+		return 0
+	}
+	return (last.value - first.value) / dt
+}
+
+// ReleaseVelocity returns how fast the value was moving, in value units
+// per second, when the event last passed to HandleMouse released a drag.
+// It is 0 for any other event, or if the pointer had stopped before the
+// release. Hand it to NewFlingAnimation to let the value coast after a
+// flick.
+func (m *MouseState) ReleaseVelocity() float64 {
+	return m.releaseVelocity
+}
+
+// page moves the value by PageStep steps from the handle toward the pointer.
+func (m *MouseState) page(pos, anchor int, slider *Slider) {
+	dir := pos - anchor
+	if slider.orientation == Vertical {
+		dir = -dir
+	}
+	if dir == 0 {
+		return
+	}
+
+	steps := m.PageStep
+	if steps < 1 {
+		steps = 1
+	}
+
+	for i := 0; i < steps; i++ {
+		if dir > 0 {
+			slider.state.Increment()
+		} else {
+			slider.state.Decrement()
 		}
 	}
+}
 
-	// Clamp percentage
-	if percentage < 0 {
-		percentage = 0
+// handleSpan returns the handle's screen position and length along the
+// track axis. The length is zero when the slider draws no handle.
+func (m *MouseState) handleSpan(slider *Slider) (start, length int) {
+	track, handle := slider.layout()
+	if handle.Width == 0 || handle.Height == 0 {
+		return 0, 0
 	}
-	if percentage > 1 {
-		percentage = 1
+
+	if slider.orientation == Vertical {
+		return m.Y + handle.Y - track.Y, handle.Height
 	}
+	return m.X + handle.X - track.X, handle.Width
+}
 
-	// Update slider state
-	slider.state.SetFromPercentage(percentage)
+// axisPosition returns the coordinate along the slider's track axis.
+func axisPosition(x, y int, o Orientation) int {
+	if o == Vertical {
+		return y
+	}
+	return x
+}
+
+// handleWheel applies a wheel event as Increment/Decrement steps.
+// Returns false if the wheel direction doesn't apply to the slider's orientation.
+func (m *MouseState) handleWheel(msg tea.MouseMsg, slider *Slider) bool {
+	dir := wheelDirection(msg.Button, slider.orientation)
+	if dir == 0 {
+		return false
+	}
+	if m.InvertWheel {
+		dir = -dir
+	}
+
+	steps := m.WheelStep
+	if steps < 1 {
+		steps = 1
+	}
+
+	for i := 0; i < steps; i++ {
+		if dir > 0 {
+			slider.state.Increment()
+		} else {
+			slider.state.Decrement()
+		}
+	}
+
+	return true
+}
+
+// wheelDirection maps a wheel button to +1 (increase), -1 (decrease) or 0.
+// Vertical wheel motion works for both orientations; horizontal wheel
+// motion only applies to horizontal sliders.
+func wheelDirection(button tea.MouseButton, o Orientation) int {
+	switch button {
+	case tea.MouseButtonWheelUp:
+		return 1
+	case tea.MouseButtonWheelDown:
+		return -1
+	case tea.MouseButtonWheelRight:
+		if o == Horizontal {
+			return 1
+		}
+	case tea.MouseButtonWheelLeft:
+		if o == Horizontal {
+			return -1
+		}
+	}
+	return 0
+}
+
+// updateValue updates the slider value based on mouse position.
+func (m *MouseState) updateValue(mouseX, mouseY int, slider *Slider) {
+	slider.state.SetFromPercentage(m.percentageAt(mouseX, mouseY, slider))
+}
+
+// percentageAt returns the percentage (0.0 to 1.0) under a screen position,
+// using the slider's own layout so clicks land on the handle position drawn
+// at that cell. The bounds are expected to match the slider's TrackRect.
+func (m *MouseState) percentageAt(mouseX, mouseY int, slider *Slider) float64 {
+	return slider.PercentageAt(mouseX-m.X, mouseY-m.Y)
+}
+
+// updateHover tracks whether the pointer is over the track, mirrors the
+// hover onto the slider and queues enter/leave messages for HoverCmd.
+func (m *MouseState) updateHover(msg tea.MouseMsg, slider *Slider) {
+	hovered := m.Contains(msg.X, msg.Y)
+
+	if hovered {
+		pct := m.percentageAt(msg.X, msg.Y, slider)
+		state := slider.state
+		m.HoverValue = state.Min() + pct*state.Range()
+		slider.SetHover(m.HoverValue)
+	} else if m.Hovered {
+		slider.ClearHover()
+	}
+
+	if hovered == m.Hovered {
+		return
+	}
+	m.Hovered = hovered
+
+	if hovered {
+		m.pendingHover = append(m.pendingHover, SliderHoverEnterMsg{Index: m.index, Value: m.HoverValue})
+	} else {
+		m.pendingHover = append(m.pendingHover, SliderHoverLeaveMsg{Index: m.index})
+	}
+}
+
+// HoverCmd returns a command delivering the enter/leave messages queued
+// since the last call, in order, or nil if the hover state didn't change.
+// Call it after HandleMouse and return it from your Update function.
+func (m *MouseState) HoverCmd() tea.Cmd {
+	return sequenceMsgs(m.takeHover())
+}
+
+// takeHover returns and clears the queued hover messages.
+func (m *MouseState) takeHover() []tea.Msg {
+	msgs := m.pendingHover
+	m.pendingHover = nil
+	return msgs
+}
+
+// sequenceMsgs returns a command delivering msgs in order, or nil if empty.
+func sequenceMsgs(msgs []tea.Msg) tea.Cmd {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	cmds := make([]tea.Cmd, len(msgs))
+	for i, msg := range msgs {
+		msg := msg
+		cmds[i] = func() tea.Msg { return msg }
+	}
+	if len(cmds) == 1 {
+		return cmds[0]
+	}
+	return tea.Sequence(cmds...)
+}
+
+// SliderHoverEnterMsg is sent when the pointer moves onto a slider's track.
+type SliderHoverEnterMsg struct {
+	Index int     // Slider index within its SliderGroup, or -1 for a standalone MouseState
+	Value float64 // Value under the pointer
+}
+
+// SliderHoverLeaveMsg is sent when the pointer leaves a slider's track.
+type SliderHoverLeaveMsg struct {
+	Index int // Slider index within its SliderGroup, or -1 for a standalone MouseState
 }
 
 // MouseHandler is a helper interface for components that want
@@ -128,20 +471,24 @@ type SliderGroup struct {
 	sliders    []*Slider
 	mouseState []*MouseState
 	focused    int // Currently focused slider index (-1 if none)
+	zones      *ZoneManager
 }
 
 // NewSliderGroup creates a new slider group.
 func NewSliderGroup() *SliderGroup {
 	return &SliderGroup{
 		focused: -1,
+		zones:   NewZoneManager(),
 	}
 }
 
 // Add adds a slider to the group and returns its index.
 func (g *SliderGroup) Add(slider *Slider) int {
 	idx := len(g.sliders)
+	ms := NewMouseState()
+	ms.index = idx
 	g.sliders = append(g.sliders, slider)
-	g.mouseState = append(g.mouseState, NewMouseState())
+	g.mouseState = append(g.mouseState, ms)
 	return idx
 }
 
@@ -171,13 +518,50 @@ func (g *SliderGroup) Focused() int {
 	return g.focused
 }
 
-// SetFocused sets the focused slider index.
+// SetFocused sets the focused slider index. Disabled sliders can't take
+// focus.
 func (g *SliderGroup) SetFocused(idx int) {
-	if idx >= -1 && idx < len(g.sliders) {
+	if idx == -1 || g.focusable(idx) {
 		g.focused = idx
 	}
 }
 
+// FocusNext moves focus to the next slider that can take it, wrapping
+// around and skipping disabled sliders, and returns its index. Focus is
+// unchanged (and -1 returned if nothing is focused) when no slider can
+// take it.
+func (g *SliderGroup) FocusNext() int {
+	return g.moveFocus(1)
+}
+
+// FocusPrev moves focus to the previous slider that can take it, like
+// FocusNext.
+func (g *SliderGroup) FocusPrev() int {
+	return g.moveFocus(-1)
+}
+
+// moveFocus steps focus by dir (1 or -1) to the nearest focusable slider.
+func (g *SliderGroup) moveFocus(dir int) int {
+	n := len(g.sliders)
+	start := g.focused
+	if start < 0 && dir < 0 {
+		start = n
+	}
+	for i := 1; i <= n; i++ {
+		idx := ((start+dir*i)%n + n) % n
+		if g.focusable(idx) {
+			g.focused = idx
+			return idx
+		}
+	}
+	return g.focused
+}
+
+// focusable reports whether the slider at idx can take focus.
+func (g *SliderGroup) focusable(idx int) bool {
+	return idx >= 0 && idx < len(g.sliders) && g.sliders[idx] != nil && !g.sliders[idx].disabled
+}
+
 // SetBounds sets the bounds for a specific slider.
 func (g *SliderGroup) SetBounds(idx, x, y, width, height int) {
 	if idx >= 0 && idx < len(g.mouseState) {
@@ -185,13 +569,55 @@ func (g *SliderGroup) SetBounds(idx, x, y, width, height int) {
 	}
 }
 
+// View renders the slider at the given index wrapped in zone markers.
+// Place the result anywhere in your layout (decorations around it are
+// fine) and pass the final view through Scan so the group can find the
+// slider's track.
+func (g *SliderGroup) View(idx int) string {
+	if idx < 0 || idx >= len(g.sliders) {
+		return ""
+	}
+	return g.zones.Mark(strconv.Itoa(idx), g.sliders[idx].View())
+}
+
+// Scan strips zone markers from the composed view and updates the bounds
+// of every marked slider to its track's screen position.
+// Call it on the final string returned from your model's View method.
+func (g *SliderGroup) Scan(view string) string {
+	clean := g.zones.Scan(view)
+	for i, ms := range g.mouseState {
+		if r, ok := g.zones.Get(strconv.Itoa(i)); ok {
+			ms.SetTrackBounds(r.X, r.Y, g.sliders[i])
+		}
+	}
+	return clean
+}
+
 // HandleMouse processes a mouse event for all sliders in the group.
+// Wheel events go to the slider under the pointer without changing focus.
 // Returns true if any slider was interacted with.
 func (g *SliderGroup) HandleMouse(msg tea.MouseMsg) bool {
+	// Keep hover state current for every slider, not just the one handling
+	// the event; the loops below skip it
+	for i, ms := range g.mouseState {
+		if g.sliders[i] != nil && g.sliders[i].state != nil {
+			ms.updateHover(msg, g.sliders[i])
+		}
+	}
+
+	if tea.MouseEvent(msg).IsWheel() {
+		for i, ms := range g.mouseState {
+			if ms.Contains(msg.X, msg.Y) {
+				return ms.handle(msg, g.sliders[i])
+			}
+		}
+		return false
+	}
+
 	// Check if any slider is being dragged
 	for i, ms := range g.mouseState {
 		if ms.Dragging {
-			if ms.HandleMouse(msg, g.sliders[i]) {
+			if ms.handle(msg, g.sliders[i]) {
 				g.focused = i
 				return true
 			}
@@ -200,7 +626,7 @@ func (g *SliderGroup) HandleMouse(msg tea.MouseMsg) bool {
 
 	// Check for new clicks on any slider
 	for i, ms := range g.mouseState {
-		if ms.HandleMouse(msg, g.sliders[i]) {
+		if ms.handle(msg, g.sliders[i]) {
 			g.focused = i
 			// Clear other slider focus
 			for j := range g.mouseState {
@@ -215,6 +641,33 @@ func (g *SliderGroup) HandleMouse(msg tea.MouseMsg) bool {
 	return false
 }
 
+// Hovered returns the index of the slider under the pointer, or -1 if none.
+func (g *SliderGroup) Hovered() int {
+	for i, ms := range g.mouseState {
+		if ms.Hovered {
+			return i
+		}
+	}
+	return -1
+}
+
+// HoverCmd returns a command delivering queued hover enter/leave messages
+// for all sliders in the group, or nil if nothing changed.
+// Leave messages are delivered before enter messages.
+func (g *SliderGroup) HoverCmd() tea.Cmd {
+	var leaves, enters []tea.Msg
+	for _, ms := range g.mouseState {
+		for _, msg := range ms.takeHover() {
+			if _, ok := msg.(SliderHoverLeaveMsg); ok {
+				leaves = append(leaves, msg)
+			} else {
+				enters = append(enters, msg)
+			}
+		}
+	}
+	return sequenceMsgs(append(leaves, enters...))
+}
+
 // EnableMouse returns a tea.ProgramOption that enables mouse support.
 // Use this when creating your Bubble Tea program:
 //
@@ -1,9 +1,14 @@
 package tuslide
 
 import (
+	"math"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestNewMouseState(t *testing.T) {
@@ -210,6 +215,63 @@ func TestMouseState_HandleMouse_VerticalSlider(t *testing.T) {
 	}
 }
 
+func TestMouseState_HandleMouse_SegmentedBordered(t *testing.T) {
+	state := NewState(WithMin(0), WithMax(100))
+	slider := New(state, WithSegmented(true), WithSegmentCount(5), WithSegmentGap(1),
+		WithBorder(BorderRounded), WithLabel("Vol"), WithLabelPosition(LabelLeft))
+
+	ms := NewMouseState()
+	ms.SetTrackBounds(10, 5, slider)
+
+	// Segments start at columns 0, 2, 4, 6, 8 of the track
+	tests := []struct {
+		col      int
+		expected float64
+	}{
+		{0, 0},
+		{2, 20},
+		{4, 40},
+		{6, 60},
+		{8, 100},
+	}
+
+	for _, tt := range tests {
+		msg := tea.MouseMsg{X: ms.X + tt.col, Y: ms.Y, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+		ms.HandleMouse(msg, slider)
+		ms.HandleMouse(tea.MouseMsg{X: msg.X, Y: msg.Y, Action: tea.MouseActionRelease}, slider)
+		if state.Value() != tt.expected {
+			t.Errorf("Click on segment column %d: expected %f, got %f", tt.col, tt.expected, state.Value())
+		}
+	}
+}
+
+func TestMouseState_ClicksWithCues(t *testing.T) {
+	state := NewState(WithMax(100))
+	slider := New(state, WithWidth(11), WithSymbols(Symbols{Filled: "=", Empty: "-", Handle: "O"}))
+	accessible := NewAccessibleSlider(slider, WithStateCue(InteractionFocused, CueMarker|CueBrackets))
+	accessible.SetFocused(true)
+
+	// The handle is drawn where the click was, past the marker and bracket
+	handleCol := func() int {
+		view := accessible.View()
+		return lipgloss.Width(view[:strings.Index(view, "O")])
+	}
+	start := handleCol()
+
+	ms := NewMouseState()
+	ms.SetTrackBounds(0, 0, slider)
+	if ms.X != start {
+		t.Fatalf("Expected the track at column %d, got %d", start, ms.X)
+	}
+	for _, col := range []int{start + 3, start + 10, start} {
+		ms.HandleMouse(tea.MouseMsg{X: col, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}, slider)
+		ms.HandleMouse(tea.MouseMsg{X: col, Action: tea.MouseActionRelease}, slider)
+		if got := handleCol(); got != col {
+			t.Errorf("Click on column %d drew the handle at %d", col, got)
+		}
+	}
+}
+
 func TestMouseState_HandleMouse_NilSlider(t *testing.T) {
 	ms := NewMouseState()
 	ms.SetBounds(0, 0, 100, 10)
@@ -248,6 +310,361 @@ func TestMouseState_HandleMouse_NilState(t *testing.T) {
 	}
 }
 
+func TestMouseState_HandleMouse_Wheel(t *testing.T) {
+	ms := NewMouseState()
+	ms.SetBounds(0, 0, 100, 1)
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(50), WithStep(5))
+	slider := New(state, WithWidth(100))
+
+	tests := []struct {
+		name     string
+		button   tea.MouseButton
+		expected float64
+	}{
+		{"wheel up", tea.MouseButtonWheelUp, 55},
+		{"wheel down", tea.MouseButtonWheelDown, 50},
+		{"wheel right", tea.MouseButtonWheelRight, 55},
+		{"wheel left", tea.MouseButtonWheelLeft, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := tea.MouseMsg{X: 10, Y: 0, Button: tt.button, Action: tea.MouseActionPress}
+			if !ms.HandleMouse(msg, slider) {
+				t.Error("Wheel event inside bounds should be handled")
+			}
+			if state.Value() != tt.expected {
+				t.Errorf("Expected value %f, got %f", tt.expected, state.Value())
+			}
+		})
+	}
+
+	if ms.Dragging {
+		t.Error("Wheel events should not start dragging")
+	}
+}
+
+func TestMouseState_HandleMouse_WheelStepAndInvert(t *testing.T) {
+	ms := NewMouseState()
+	ms.SetBounds(0, 0, 100, 1)
+	ms.WheelStep = 3
+	ms.InvertWheel = true
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(50))
+	slider := New(state, WithWidth(100))
+
+	msg := tea.MouseMsg{X: 10, Y: 0, Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress}
+	ms.HandleMouse(msg, slider)
+
+	if state.Value() != 47 {
+		t.Errorf("Expected value 47, got %f", state.Value())
+	}
+}
+
+func TestMouseState_HandleMouse_WheelOutsideAndVertical(t *testing.T) {
+	ms := NewMouseState()
+	ms.SetBounds(0, 0, 1, 10)
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(50))
+	slider := New(state, WithHeight(10), WithOrientation(Vertical))
+
+	// Outside bounds
+	msg := tea.MouseMsg{X: 5, Y: 5, Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress}
+	if ms.HandleMouse(msg, slider) {
+		t.Error("Wheel event outside bounds should not be handled")
+	}
+
+	// Horizontal wheel does not apply to vertical sliders
+	msg = tea.MouseMsg{X: 0, Y: 5, Button: tea.MouseButtonWheelRight, Action: tea.MouseActionPress}
+	if ms.HandleMouse(msg, slider) {
+		t.Error("Horizontal wheel should not be handled by vertical slider")
+	}
+	if state.Value() != 50 {
+		t.Errorf("Expected value unchanged at 50, got %f", state.Value())
+	}
+}
+
+func TestMouseState_DragHandleMode(t *testing.T) {
+	ms := NewMouseState()
+	ms.Mode = DragHandle
+	ms.SetBounds(0, 0, 101, 1)
+
+	// 101 cells leave 100 for filled/empty, so the handle sits at column 50
+	state := NewState(WithMin(0), WithMax(100), WithValue(50))
+	slider := New(state, WithWidth(101))
+
+	// Press away from the handle is ignored
+	press := tea.MouseMsg{X: 10, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+	if !ms.HandleMouse(press, slider) {
+		t.Error("Press inside bounds should be handled")
+	}
+	if ms.Dragging {
+		t.Error("Press off the handle should not start a drag")
+	}
+	if state.Value() != 50 {
+		t.Errorf("Press off the handle should not change value, got %f", state.Value())
+	}
+
+	// Grab the handle and drag 20 cells right
+	press.X = 50
+	ms.HandleMouse(press, slider)
+	if !ms.Dragging {
+		t.Fatal("Press on the handle should start a drag")
+	}
+	if state.Value() != 50 {
+		t.Errorf("Grabbing the handle should not change value, got %f", state.Value())
+	}
+
+	motion := tea.MouseMsg{X: 70, Y: 0, Action: tea.MouseActionMotion}
+	ms.HandleMouse(motion, slider)
+	if math.Abs(state.Value()-70) > 1.5 {
+		t.Errorf("Expected value near 70 after drag, got %f", state.Value())
+	}
+}
+
+func TestMouseState_DragRelativeMode(t *testing.T) {
+	ms := NewMouseState()
+	ms.Mode = DragRelative
+	ms.SetBounds(0, 0, 100, 1)
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(50))
+	slider := New(state, WithWidth(100))
+
+	// Pressing far from the handle does not jump
+	ms.HandleMouse(tea.MouseMsg{X: 90, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}, slider)
+	if state.Value() != 50 {
+		t.Errorf("Relative press should not change value, got %f", state.Value())
+	}
+
+	// Move 10 cells right: 10% of the range
+	ms.HandleMouse(tea.MouseMsg{X: 100, Y: 0, Action: tea.MouseActionMotion}, slider)
+	if math.Abs(state.Value()-60) > 0.001 {
+		t.Errorf("Expected value 60, got %f", state.Value())
+	}
+
+	// Precision modifier slows movement by PrecisionFactor
+	ms.HandleMouse(tea.MouseMsg{X: 90, Y: 0, Shift: true, Action: tea.MouseActionMotion}, slider)
+	if math.Abs(state.Value()-59) > 0.001 {
+		t.Errorf("Expected value 59 with precision modifier, got %f", state.Value())
+	}
+
+	ms.HandleMouse(tea.MouseMsg{X: 90, Y: 0, Action: tea.MouseActionRelease}, slider)
+	if ms.Dragging {
+		t.Error("Release should end the drag")
+	}
+}
+
+func TestMouseState_ReleaseVelocity(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	ms := NewMouseState()
+	ms.Mode = DragRelative
+	ms.Clock = clock
+	ms.SetBounds(0, 0, 100, 1)
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(20))
+	slider := New(state, WithWidth(100))
+
+	// Flick right: 10 cells (10 units) every 20ms is 500 units per second
+	ms.HandleMouse(tea.MouseMsg{X: 20, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}, slider)
+	for x := 30; x <= 60; x += 10 {
+		clock.Advance(20 * time.Millisecond)
+		ms.HandleMouse(tea.MouseMsg{X: x, Y: 0, Action: tea.MouseActionMotion}, slider)
+	}
+	ms.HandleMouse(tea.MouseMsg{X: 60, Y: 0, Action: tea.MouseActionRelease}, slider)
+
+	if v := ms.ReleaseVelocity(); math.Abs(v-500) > 0.001 {
+		t.Errorf("Expected release velocity 500, got %f", v)
+	}
+
+	// Only the releasing event reports a velocity
+	ms.HandleMouse(tea.MouseMsg{X: 60, Y: 0, Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress}, slider)
+	if v := ms.ReleaseVelocity(); v != 0 {
+		t.Errorf("Expected no velocity after another event, got %f", v)
+	}
+
+	// Stopping before letting go doesn't fling
+	ms.HandleMouse(tea.MouseMsg{X: 60, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}, slider)
+	clock.Advance(20 * time.Millisecond)
+	ms.HandleMouse(tea.MouseMsg{X: 50, Y: 0, Action: tea.MouseActionMotion}, slider)
+	clock.Advance(200 * time.Millisecond)
+	ms.HandleMouse(tea.MouseMsg{X: 50, Y: 0, Action: tea.MouseActionRelease}, slider)
+
+	if v := ms.ReleaseVelocity(); v != 0 {
+		t.Errorf("Expected no velocity after pausing, got %f", v)
+	}
+}
+
+func TestMouseState_DragRelativeVertical(t *testing.T) {
+	ms := NewMouseState()
+	ms.Mode = DragRelative
+	ms.SetBounds(0, 0, 1, 10)
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(50))
+	slider := New(state, WithHeight(10), WithOrientation(Vertical))
+
+	ms.HandleMouse(tea.MouseMsg{X: 0, Y: 5, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}, slider)
+	ms.HandleMouse(tea.MouseMsg{X: 0, Y: 3, Action: tea.MouseActionMotion}, slider)
+
+	if math.Abs(state.Value()-70) > 0.001 {
+		t.Errorf("Moving up two rows should add 20%%, got %f", state.Value())
+	}
+}
+
+func TestMouseState_PageOnTrackClick(t *testing.T) {
+	ms := NewMouseState()
+	ms.PageOnTrackClick = true
+	ms.PageStep = 2
+	ms.SetBounds(0, 0, 101, 1)
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(50), WithStep(5))
+	slider := New(state, WithWidth(101))
+
+	press := tea.MouseMsg{X: 90, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+	ms.HandleMouse(press, slider)
+	if state.Value() != 60 {
+		t.Errorf("Click right of handle should page up to 60, got %f", state.Value())
+	}
+	if ms.Dragging {
+		t.Error("Paging should not start a drag")
+	}
+
+	press.X = 5
+	ms.HandleMouse(press, slider)
+	if state.Value() != 50 {
+		t.Errorf("Click left of handle should page down to 50, got %f", state.Value())
+	}
+
+	// Pressing the handle still drags in jump mode
+	press.X = 50
+	ms.HandleMouse(press, slider)
+	if !ms.Dragging {
+		t.Error("Press on the handle should start a drag")
+	}
+}
+
+func TestMouseState_PageOnTrackClickVertical(t *testing.T) {
+	ms := NewMouseState()
+	ms.PageOnTrackClick = true
+	ms.SetBounds(0, 0, 1, 10)
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(50))
+	slider := New(state, WithHeight(10), WithOrientation(Vertical))
+
+	// Row 0 is above the handle, so the value increases
+	ms.HandleMouse(tea.MouseMsg{X: 0, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}, slider)
+	if state.Value() != 51 {
+		t.Errorf("Click above handle should increment, got %f", state.Value())
+	}
+}
+
+// collectMsgs runs a command and flattens sequenced messages.
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	v := reflect.ValueOf(msg)
+	if v.Kind() == reflect.Slice {
+		var msgs []tea.Msg
+		for i := 0; i < v.Len(); i++ {
+			if c, ok := v.Index(i).Interface().(tea.Cmd); ok {
+				msgs = append(msgs, collectMsgs(c)...)
+			}
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+func TestMouseState_Hover(t *testing.T) {
+	ms := NewMouseState()
+	ms.SetBounds(0, 0, 100, 1)
+
+	state := NewState(WithMin(0), WithMax(100), WithValue(10))
+	slider := New(state, WithWidth(100))
+
+	if ms.HoverCmd() != nil {
+		t.Error("HoverCmd should be nil before any events")
+	}
+
+	motion := tea.MouseMsg{X: 50, Y: 0, Action: tea.MouseActionMotion}
+	if ms.HandleMouse(motion, slider) {
+		t.Error("Hover alone should not count as interaction")
+	}
+	if !ms.Hovered || !slider.IsHovered() {
+		t.Error("Pointer over the track should set hover")
+	}
+	if math.Abs(ms.HoverValue-50) > 1 || math.Abs(slider.HoverValue()-ms.HoverValue) > 0.001 {
+		t.Errorf("Expected hover value near 50, got %f", ms.HoverValue)
+	}
+	if state.Value() != 10 {
+		t.Error("Hover should not change the value")
+	}
+
+	msgs := collectMsgs(ms.HoverCmd())
+	if len(msgs) != 1 {
+		t.Fatalf("Expected 1 hover message, got %d", len(msgs))
+	}
+	if enter, ok := msgs[0].(SliderHoverEnterMsg); !ok || enter.Index != -1 {
+		t.Errorf("Expected standalone enter message, got %#v", msgs[0])
+	}
+
+	// Moving within the track doesn't fire again
+	motion.X = 60
+	ms.HandleMouse(motion, slider)
+	if ms.HoverCmd() != nil {
+		t.Error("Moving within the track should not queue messages")
+	}
+
+	motion.Y = 3
+	ms.HandleMouse(motion, slider)
+	if ms.Hovered || slider.IsHovered() {
+		t.Error("Leaving the track should clear hover")
+	}
+	msgs = collectMsgs(ms.HoverCmd())
+	if len(msgs) != 1 {
+		t.Fatalf("Expected 1 hover message, got %d", len(msgs))
+	}
+	if _, ok := msgs[0].(SliderHoverLeaveMsg); !ok {
+		t.Errorf("Expected leave message, got %#v", msgs[0])
+	}
+}
+
+func TestSliderGroup_Hover(t *testing.T) {
+	group := NewSliderGroup()
+	group.Add(New(NewState(), WithWidth(100)))
+	group.SetBounds(0, 0, 0, 100, 1)
+	group.Add(New(NewState(), WithWidth(100)))
+	group.SetBounds(1, 0, 2, 100, 1)
+
+	if group.Hovered() != -1 {
+		t.Error("No slider should be hovered initially")
+	}
+
+	group.HandleMouse(tea.MouseMsg{X: 10, Y: 2, Action: tea.MouseActionMotion})
+	if group.Hovered() != 1 {
+		t.Errorf("Expected slider 1 hovered, got %d", group.Hovered())
+	}
+	collectMsgs(group.HoverCmd())
+
+	group.HandleMouse(tea.MouseMsg{X: 10, Y: 0, Action: tea.MouseActionMotion})
+	if group.Hovered() != 0 {
+		t.Errorf("Expected slider 0 hovered, got %d", group.Hovered())
+	}
+
+	msgs := collectMsgs(group.HoverCmd())
+	if len(msgs) != 2 {
+		t.Fatalf("Expected 2 hover messages, got %d", len(msgs))
+	}
+	if leave, ok := msgs[0].(SliderHoverLeaveMsg); !ok || leave.Index != 1 {
+		t.Errorf("Expected leave for slider 1 first, got %#v", msgs[0])
+	}
+	if enter, ok := msgs[1].(SliderHoverEnterMsg); !ok || enter.Index != 0 {
+		t.Errorf("Expected enter for slider 0 second, got %#v", msgs[1])
+	}
+}
+
 func TestSliderGroup_Add(t *testing.T) {
 	group := NewSliderGroup()
 
@@ -358,6 +775,51 @@ func TestSliderGroup_SetBounds(t *testing.T) {
 	group.SetBounds(100, 0, 0, 0, 0)
 }
 
+func TestMouseState_SetTrackBounds(t *testing.T) {
+	ms := NewMouseState()
+	slider := New(NewState(), WithWidth(20), WithLabel("Vol"), WithLabelPosition(LabelLeft))
+
+	ms.SetTrackBounds(5, 3, slider)
+
+	if ms.X != 9 || ms.Y != 3 || ms.Width != 20 || ms.Height != 1 {
+		t.Errorf("Unexpected bounds: %+v", *ms)
+	}
+
+	// Nil slider should not panic
+	ms.SetTrackBounds(0, 0, nil)
+}
+
+func TestSliderGroup_ViewAndScan(t *testing.T) {
+	group := NewSliderGroup()
+
+	state1 := NewState()
+	group.Add(New(state1, WithWidth(10), WithLabel("A"), WithLabelPosition(LabelLeft)))
+
+	state2 := NewState()
+	group.Add(New(state2, WithWidth(10), WithBorder(BorderRounded)))
+
+	view := "Title\n\n" + group.View(0) + "\n" + group.View(1)
+	clean := group.Scan(view)
+
+	if strings.Contains(clean, "\x1b[7") {
+		t.Error("Scan should strip zone markers")
+	}
+
+	ms := group.GetMouseState(0)
+	if ms.X != 2 || ms.Y != 2 || ms.Width != 10 || ms.Height != 1 {
+		t.Errorf("Unexpected bounds for slider 0: %+v", *ms)
+	}
+
+	ms = group.GetMouseState(1)
+	if ms.X != 1 || ms.Y != 4 || ms.Width != 10 || ms.Height != 1 {
+		t.Errorf("Unexpected bounds for slider 1: %+v", *ms)
+	}
+
+	if group.View(5) != "" {
+		t.Error("View for invalid index should be empty")
+	}
+}
+
 func TestSliderGroup_HandleMouse(t *testing.T) {
 	group := NewSliderGroup()
 
@@ -403,6 +865,40 @@ func TestSliderGroup_HandleMouse(t *testing.T) {
 	}
 }
 
+func TestSliderGroup_HandleMouse_Wheel(t *testing.T) {
+	group := NewSliderGroup()
+
+	state1 := NewState(WithValue(50))
+	group.Add(New(state1, WithWidth(100)))
+	group.SetBounds(0, 0, 0, 100, 1)
+
+	state2 := NewState(WithValue(50))
+	group.Add(New(state2, WithWidth(100)))
+	group.SetBounds(1, 0, 2, 100, 1)
+
+	group.SetFocused(0)
+
+	msg := tea.MouseMsg{X: 10, Y: 2, Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress}
+	if !group.HandleMouse(msg) {
+		t.Error("Wheel over second slider should be handled")
+	}
+	if state2.Value() != 51 {
+		t.Errorf("Expected second slider value 51, got %f", state2.Value())
+	}
+	if state1.Value() != 50 {
+		t.Errorf("First slider should be unchanged, got %f", state1.Value())
+	}
+	if group.Focused() != 0 {
+		t.Errorf("Wheel should not change focus, got %d", group.Focused())
+	}
+
+	// Wheel over empty space
+	msg.Y = 5
+	if group.HandleMouse(msg) {
+		t.Error("Wheel outside all sliders should not be handled")
+	}
+}
+
 func TestEnableMouse(t *testing.T) {
 	opt := EnableMouse()
 	if opt == nil {
@@ -416,3 +912,63 @@ func TestEnableMouseAllMotion(t *testing.T) {
 		t.Error("EnableMouseAllMotion should return a non-nil option")
 	}
 }
+
+func TestMouseState_HandleMouse_Locked(t *testing.T) {
+	press := tea.MouseMsg{X: 80, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+	wheel := tea.MouseMsg{X: 80, Y: 0, Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress}
+
+	state := NewState(WithValue(50))
+	slider := New(state, WithWidth(100), WithDisabled(true))
+	ms := NewMouseState()
+	ms.SetBounds(0, 0, 100, 1)
+
+	if ms.HandleMouse(press, slider) || ms.HandleMouse(wheel, slider) {
+		t.Error("Disabled slider should ignore the mouse")
+	}
+	if ms.Focused || ms.Dragging || state.Value() != 50 {
+		t.Errorf("Disabled slider should not change, value %v", state.Value())
+	}
+
+	// Read-only sliders take focus but keep their value
+	slider.SetDisabled(false)
+	slider.SetReadOnly(true)
+	if !ms.HandleMouse(press, slider) || !ms.Focused {
+		t.Error("Read-only slider should take focus on a click")
+	}
+	if ms.HandleMouse(wheel, slider) || ms.Dragging || state.Value() != 50 {
+		t.Errorf("Read-only slider should not change, value %v", state.Value())
+	}
+}
+
+func TestSliderGroup_FocusTraversal(t *testing.T) {
+	group := NewSliderGroup()
+	group.Add(New(NewState()))
+	group.Add(New(NewState(), WithDisabled(true)))
+	group.Add(New(NewState(), WithReadOnly(true)))
+
+	for _, expected := range []int{0, 2, 0} {
+		if got := group.FocusNext(); got != expected {
+			t.Errorf("FocusNext: expected %d, got %d", expected, got)
+		}
+	}
+	if got := group.FocusPrev(); got != 2 {
+		t.Errorf("FocusPrev: expected 2, got %d", got)
+	}
+
+	group.SetFocused(1)
+	if group.Focused() != 2 {
+		t.Error("Disabled sliders should not take focus")
+	}
+
+	// Clicks don't focus disabled sliders either
+	group.SetBounds(1, 0, 0, 20, 1)
+	if group.HandleMouse(tea.MouseMsg{X: 5, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}) {
+		t.Error("Click on a disabled slider should not be handled")
+	}
+
+	empty := NewSliderGroup()
+	empty.Add(New(NewState(), WithDisabled(true)))
+	if got := empty.FocusNext(); got != -1 {
+		t.Errorf("Expected no focus without focusable sliders, got %d", got)
+	}
+}
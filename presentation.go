@@ -0,0 +1,501 @@
+package tuslide
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// StylePart identifies a part of a slider that can be styled.
+type StylePart int
+
+const (
+	// PartFilled is the filled portion of the track.
+	PartFilled StylePart = iota
+	// PartEmpty is the empty portion of the track.
+	PartEmpty
+	// PartHandle is the handle.
+	PartHandle
+)
+
+// Presentation holds per-frame style overrides that a Slider layers over
+// its own (and hover) styles when rendering. Presentation animators such
+// as ColorFade and Shimmer write to it every frame; custom animators can
+// do the same through Slider.Presentation.
+type Presentation struct {
+	styles     [3]lipgloss.Style
+	glint      bool
+	glintPos   float64 // Center of the glint along the filled portion, 0-1
+	glintWidth float64 // Width of the glint as a fraction of the filled portion
+	glintStyle lipgloss.Style
+}
+
+// newPresentation creates a presentation with no overrides.
+func newPresentation() *Presentation {
+	p := &Presentation{}
+	p.Reset()
+	return p
+}
+
+// Style returns the override for part. Properties it doesn't set fall
+// back to the slider's styles.
+func (p *Presentation) Style(part StylePart) lipgloss.Style {
+	return p.styles[part]
+}
+
+// SetStyle replaces the override for part.
+func (p *Presentation) SetStyle(part StylePart, style lipgloss.Style) {
+	p.styles[part] = style
+}
+
+// SetColor overrides the foreground color of part.
+func (p *Presentation) SetColor(part StylePart, c lipgloss.TerminalColor) {
+	p.styles[part] = p.styles[part].Foreground(c)
+}
+
+// ClearColor removes the foreground color override of part.
+func (p *Presentation) ClearColor(part StylePart) {
+	p.styles[part] = p.styles[part].UnsetForeground()
+}
+
+// SetGlint draws a band of the filled portion with style. The band is
+// centered at pos and spans width, both as fractions of the filled
+// portion; it is always at least one cell wide while it overlaps it.
+func (p *Presentation) SetGlint(pos, width float64, style lipgloss.Style) {
+	p.glint = true
+	p.glintPos = pos
+	p.glintWidth = width
+	p.glintStyle = style
+}
+
+// ClearGlint removes the glint.
+func (p *Presentation) ClearGlint() {
+	p.glint = false
+}
+
+// Reset removes all overrides.
+func (p *Presentation) Reset() {
+	for i := range p.styles {
+		p.styles[i] = lipgloss.NewStyle()
+	}
+	p.glint = false
+	p.glintStyle = lipgloss.NewStyle()
+}
+
+// glintAt reports whether filled cell i of n lies inside the glint.
+func (p *Presentation) glintAt(i, n int) bool {
+	if !p.glint || n <= 0 {
+		return false
+	}
+	center := (float64(i) + 0.5) / float64(n)
+	half := math.Max(p.glintWidth, 1/float64(n)) / 2
+	return math.Abs(center-p.glintPos) <= half
+}
+
+// playhead measures an animator's elapsed time, excluding pauses.
+type playhead struct {
+	clock    Clock
+	start    time.Time
+	paused   bool
+	pausedAt time.Time
+}
+
+// newPlayhead creates a playhead started now on the real clock.
+func newPlayhead() playhead {
+	return playhead{clock: RealClock, start: RealClock.Now()}
+}
+
+// setClock switches to clock c and restarts.
+func (p *playhead) setClock(c Clock) {
+	p.clock = clockOrReal(c)
+	p.start = p.clock.Now()
+}
+
+// elapsed returns the time played so far.
+func (p *playhead) elapsed() time.Duration {
+	if p.paused {
+		return p.pausedAt.Sub(p.start)
+	}
+	return p.clock.Now().Sub(p.start)
+}
+
+// Pause freezes the animation.
+func (p *playhead) Pause() {
+	if !p.paused {
+		p.paused = true
+		p.pausedAt = p.clock.Now()
+	}
+}
+
+// Resume continues a paused animation from where it left off.
+func (p *playhead) Resume() {
+	if p.paused {
+		p.paused = false
+		p.start = p.start.Add(p.clock.Now().Sub(p.pausedAt))
+	}
+}
+
+// ColorFade fades the color of one part of a slider. It changes only the
+// slider's presentation, never its value, so it can run on the same
+// AnimationManager as a tween of the slider's state.
+type ColorFade struct {
+	id       int
+	fx       *Presentation
+	part     StylePart
+	from     lipgloss.TerminalColor
+	to       lipgloss.TerminalColor
+	duration time.Duration
+	easing   EasingFunc
+	flash    bool // Remove the override at the end, and skip under reduced motion
+	playhead
+	motionSetting
+}
+
+// NewColorFade creates an animation that fades part of slider from the
+// color it is currently drawn in to color over duration. The part keeps
+// color once the fade completes.
+func NewColorFade(slider *Slider, part StylePart, color lipgloss.TerminalColor, duration time.Duration) *ColorFade {
+	f := newColorFade(slider.fx, part, slider.partStyle(part).GetForeground(), color, duration)
+	f.states = []*SliderState{slider.state}
+	return f
+}
+
+// newColorFade creates a fade on a presentation between two colors.
+func newColorFade(fx *Presentation, part StylePart, from, to lipgloss.TerminalColor, duration time.Duration) *ColorFade {
+	return &ColorFade{
+		id:       NewAnimationID(),
+		fx:       fx,
+		part:     part,
+		from:     from,
+		to:       to,
+		duration: duration,
+		easing:   EaseInOutQuad,
+		playhead: newPlayhead(),
+	}
+}
+
+// NewHandleFlash creates an animation that flashes the slider's handle in
+// color and fades it back to its normal color over duration, e.g. to
+// confirm a committed value. Under reduced motion the handle doesn't flash.
+func NewHandleFlash(slider *Slider, color lipgloss.TerminalColor, duration time.Duration) *ColorFade {
+	f := newColorFade(slider.fx, PartHandle, color, slider.baseStyle(PartHandle).GetForeground(), duration)
+	f.easing = EaseOutQuad
+	f.flash = true
+	f.states = []*SliderState{slider.state}
+	return f
+}
+
+// WithEasing sets the easing of the fade.
+func (f *ColorFade) WithEasing(easing EasingFunc) *ColorFade {
+	f.easing = easing
+	return f
+}
+
+// WithClock sets the clock the fade reads time from and restarts it.
+func (f *ColorFade) WithClock(c Clock) *ColorFade {
+	f.setClock(c)
+	return f
+}
+
+// WithMotion sets the fade's motion preference.
+func (f *ColorFade) WithMotion(p MotionPreference) *ColorFade {
+	f.motion = p
+	return f
+}
+
+// ID returns the fade's program-wide unique ID.
+func (f *ColorFade) ID() int {
+	return f.id
+}
+
+// State returns nil: a fade doesn't change any value.
+func (f *ColorFade) State() *SliderState {
+	return nil
+}
+
+// effect keys fades on the part they color, so a new fade or flash of the
+// part replaces the running one.
+func (f *ColorFade) effect() effectKey {
+	return effectKey{target: f.fx, kind: "color", part: f.part}
+}
+
+// Update sets the part's color for the current time. Returns true when
+// the fade is complete.
+func (f *ColorFade) Update() bool {
+	if f.flash && f.effectiveMotion() != MotionFull {
+		f.fx.ClearColor(f.part)
+		return true
+	}
+	if f.paused {
+		return false
+	}
+
+	duration, easing := motionTiming(f.effectiveMotion(), f.duration, f.easing)
+	elapsed := f.elapsed()
+	if elapsed >= duration {
+		if f.flash {
+			f.fx.ClearColor(f.part)
+		} else {
+			f.fx.SetColor(f.part, f.to)
+		}
+		return true
+	}
+
+	progress := float64(elapsed) / float64(duration)
+	f.fx.SetColor(f.part, BlendColors(f.from, f.to, easing(progress)))
+	return false
+}
+
+// Tick returns a command for the next fade frame.
+func (f *ColorFade) Tick() tea.Cmd {
+	return animatorTick(f.id, DefaultFPS)
+}
+
+// HandleTick advances the fade if msg is a tick addressed to it and
+// returns the command for the next frame, or an AnimationCompleteMsg once
+// it finishes.
+func (f *ColorFade) HandleTick(msg tea.Msg) tea.Cmd {
+	return handleAnimatorTick(f, msg, DefaultFPS)
+}
+
+// Default Shimmer settings.
+const (
+	DefaultShimmerWidth  = 0.2
+	DefaultShimmerPeriod = 1500 * time.Millisecond
+)
+
+// Shimmer sweeps a glint along the filled portion of a slider, e.g. to
+// show that a progress bar is still working. It runs until Stop is called
+// and doesn't run at all under reduced motion.
+type Shimmer struct {
+	id      int
+	fx      *Presentation
+	style   lipgloss.Style
+	width   float64
+	period  time.Duration
+	stopped bool
+	playhead
+	motionSetting
+}
+
+// NewShimmer creates a shimmer that draws its glint with style.
+func NewShimmer(slider *Slider, style lipgloss.Style) *Shimmer {
+	return &Shimmer{
+		id:            NewAnimationID(),
+		fx:            slider.fx,
+		style:         style,
+		width:         DefaultShimmerWidth,
+		period:        DefaultShimmerPeriod,
+		playhead:      newPlayhead(),
+		motionSetting: motionFor(slider.state),
+	}
+}
+
+// WithWidth sets the width of the glint as a fraction of the filled portion.
+func (s *Shimmer) WithWidth(width float64) *Shimmer {
+	s.width = Clamp(width, 0, 1)
+	return s
+}
+
+// WithPeriod sets how long one sweep takes.
+func (s *Shimmer) WithPeriod(period time.Duration) *Shimmer {
+	if period > 0 {
+		s.period = period
+	}
+	return s
+}
+
+// WithClock sets the clock the shimmer reads time from and restarts it.
+func (s *Shimmer) WithClock(c Clock) *Shimmer {
+	s.setClock(c)
+	return s
+}
+
+// WithMotion sets the shimmer's motion preference.
+func (s *Shimmer) WithMotion(p MotionPreference) *Shimmer {
+	s.motion = p
+	return s
+}
+
+// ID returns the shimmer's program-wide unique ID.
+func (s *Shimmer) ID() int {
+	return s.id
+}
+
+// State returns nil: a shimmer doesn't change any value.
+func (s *Shimmer) State() *SliderState {
+	return nil
+}
+
+// effect keys shimmers on their slider.
+func (s *Shimmer) effect() effectKey {
+	return effectKey{target: s.fx, kind: "shimmer"}
+}
+
+// Stop ends the shimmer. The glint is removed on the next Update; prefer
+// this to cancelling the shimmer, which would leave the glint drawn.
+func (s *Shimmer) Stop() {
+	s.stopped = true
+}
+
+// Update moves the glint. Returns true once the shimmer has stopped.
+func (s *Shimmer) Update() bool {
+	if s.stopped || s.effectiveMotion() != MotionFull {
+		s.fx.ClearGlint()
+		return true
+	}
+	if s.paused {
+		return false
+	}
+
+	// Sweep from just before the start to just past the end
+	phase := float64(s.elapsed()%s.period) / float64(s.period)
+	pos := -s.width/2 + phase*(1+s.width)
+	s.fx.SetGlint(pos, s.width, s.style)
+	return false
+}
+
+// Tick returns a command for the next shimmer frame.
+func (s *Shimmer) Tick() tea.Cmd {
+	return animatorTick(s.id, DefaultFPS)
+}
+
+// HandleTick advances the shimmer if msg is a tick addressed to it and
+// returns the command for the next frame, or an AnimationCompleteMsg once
+// it stops.
+func (s *Shimmer) HandleTick(msg tea.Msg) tea.Cmd {
+	return handleAnimatorTick(s, msg, DefaultFPS)
+}
+
+// ColorThreshold colors a slider's fill once its value reaches At, a
+// percentage from 0 to 1.
+type ColorThreshold struct {
+	At    float64
+	Color lipgloss.TerminalColor
+}
+
+// ThresholdColors fades a slider's fill color as its value moves between
+// zones, e.g. green, then yellow, then red for a level meter. Run it on
+// the manager whenever the value changes, alongside any tween; it finishes
+// once the value has stopped moving and the color has settled.
+type ThresholdColors struct {
+	id         int
+	fx         *Presentation
+	state      *SliderState
+	thresholds []ColorThreshold
+	duration   time.Duration
+	zone       int
+	last       float64 // Value seen by the previous Update
+	primed     bool    // last is set
+	fade       *ColorFade
+	clock      Clock
+	motionSetting
+}
+
+// NewThresholdColors creates a zone coloring for slider that fades between
+// colors over duration. Values below the lowest threshold use its color.
+// The fill takes the color of the current zone immediately.
+func NewThresholdColors(slider *Slider, duration time.Duration, thresholds ...ColorThreshold) *ThresholdColors {
+	sorted := append([]ColorThreshold(nil), thresholds...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].At < sorted[j].At })
+
+	t := &ThresholdColors{
+		id:            NewAnimationID(),
+		fx:            slider.fx,
+		state:         slider.State(),
+		thresholds:    sorted,
+		duration:      duration,
+		clock:         RealClock,
+		motionSetting: motionFor(slider.state),
+	}
+
+	t.zone = t.zoneAt(t.state.Percentage())
+	if t.zone >= 0 {
+		t.fx.SetColor(PartFilled, sorted[t.zone].Color)
+	}
+
+	return t
+}
+
+// WithClock sets the clock fades read time from.
+func (t *ThresholdColors) WithClock(c Clock) *ThresholdColors {
+	t.clock = clockOrReal(c)
+	return t
+}
+
+// WithMotion sets the motion preference of the fades.
+func (t *ThresholdColors) WithMotion(p MotionPreference) *ThresholdColors {
+	t.motion = p
+	return t
+}
+
+// ID returns the animator's program-wide unique ID.
+func (t *ThresholdColors) ID() int {
+	return t.id
+}
+
+// State returns the state whose value picks the zone. Running another
+// ThresholdColors on the same state replaces this one, but zone coloring
+// never changes the value, so it doesn't replace tweens of the state.
+func (t *ThresholdColors) State() *SliderState {
+	return t.state
+}
+
+// watchesState marks ThresholdColors as following its state.
+func (t *ThresholdColors) watchesState() {}
+
+// Update starts a fade when the value has crossed into another zone and
+// advances it. Returns true once the value has stayed put for a frame and
+// the color has settled.
+func (t *ThresholdColors) Update() bool {
+	zone := t.zoneAt(t.state.Percentage())
+	if zone != t.zone && zone >= 0 {
+		t.zone = zone
+		from := t.fx.Style(PartFilled).GetForeground()
+		t.fade = newColorFade(t.fx, PartFilled, from, t.thresholds[zone].Color, t.duration)
+		t.fade.setClock(t.clock)
+	}
+
+	value := t.state.Value()
+	moving := !t.primed || value != t.last
+	t.last, t.primed = value, true
+
+	if t.fade != nil {
+		t.fade.motion = t.effectiveMotion()
+		if t.fade.Update() {
+			t.fade = nil
+		}
+	}
+
+	return t.fade == nil && !moving
+}
+
+// zoneAt returns the index of the threshold governing pct, or -1 if there
+// are no thresholds.
+func (t *ThresholdColors) zoneAt(pct float64) int {
+	if len(t.thresholds) == 0 {
+		return -1
+	}
+	zone := 0
+	for i, th := range t.thresholds {
+		if pct >= th.At {
+			zone = i
+		}
+	}
+	return zone
+}
+
+// Tick returns a command for the next frame.
+func (t *ThresholdColors) Tick() tea.Cmd {
+	return animatorTick(t.id, DefaultFPS)
+}
+
+// HandleTick advances the animator if msg is a tick addressed to it and
+// returns the command for the next frame, or an AnimationCompleteMsg once
+// it settles.
+func (t *ThresholdColors) HandleTick(msg tea.Msg) tea.Cmd {
+	return handleAnimatorTick(t, msg, DefaultFPS)
+}
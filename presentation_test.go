@@ -0,0 +1,233 @@
+package tuslide
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestPresentation_OverridesSliderStyles(t *testing.T) {
+	slider := New(NewState(WithValue(50)),
+		WithFilledStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Bold(true)),
+	)
+
+	slider.Presentation().SetColor(PartFilled, lipgloss.Color("2"))
+	style := slider.currentFilledStyle()
+	if style.GetForeground() != lipgloss.Color("2") {
+		t.Errorf("Override should win, got %v", style.GetForeground())
+	}
+	if !style.GetBold() {
+		t.Error("Unset override properties should fall back to the slider's style")
+	}
+
+	slider.Presentation().Reset()
+	if slider.currentFilledStyle().GetForeground() != lipgloss.Color("1") {
+		t.Error("Reset should restore the slider's own style")
+	}
+}
+
+func TestPresentation_Glint(t *testing.T) {
+	fx := newPresentation()
+	fx.SetGlint(0.5, 0.2, lipgloss.NewStyle())
+
+	var lit []int
+	for i := 0; i < 10; i++ {
+		if fx.glintAt(i, 10) {
+			lit = append(lit, i)
+		}
+	}
+	if len(lit) != 2 || lit[0] != 4 || lit[1] != 5 {
+		t.Errorf("Expected cells 4 and 5 lit, got %v", lit)
+	}
+
+	// Narrow glints still light one cell
+	fx.SetGlint(0.05, 0.01, lipgloss.NewStyle())
+	if !fx.glintAt(0, 10) {
+		t.Error("Narrow glint should light the cell under it")
+	}
+
+	fx.ClearGlint()
+	if fx.glintAt(0, 10) {
+		t.Error("Cleared glint should light nothing")
+	}
+}
+
+func TestColorFade(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	slider := New(NewState(), WithFilledStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#000000"))))
+	fade := NewColorFade(slider, PartFilled, lipgloss.Color("#ffffff"), 100*time.Millisecond).
+		WithEasing(Linear).WithClock(clock)
+
+	clock.Advance(50 * time.Millisecond)
+	if fade.Update() {
+		t.Error("Fade should not be complete half-way")
+	}
+	mid := slider.currentFilledStyle().GetForeground()
+	if mid == lipgloss.Color("#000000") || mid == lipgloss.Color("#ffffff") {
+		t.Errorf("Expected a mixed color half-way, got %v", mid)
+	}
+
+	clock.Advance(50 * time.Millisecond)
+	if !fade.Update() {
+		t.Error("Fade should complete after its duration")
+	}
+	if got := slider.currentFilledStyle().GetForeground(); got != lipgloss.Color("#ffffff") {
+		t.Errorf("Fill should keep the target color, got %v", got)
+	}
+}
+
+func TestHandleFlash(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	blue := lipgloss.Color("#0000ff")
+	slider := New(NewState(), WithHandleStyle(lipgloss.NewStyle().Foreground(blue)))
+
+	flash := NewHandleFlash(slider, lipgloss.Color("#ffffff"), 100*time.Millisecond).
+		WithClock(clock).WithMotion(MotionFull)
+	flash.Update()
+	if got := slider.currentHandleStyle().GetForeground(); got != lipgloss.Color("#ffffff") {
+		t.Errorf("Handle should start in the flash color, got %v", got)
+	}
+
+	clock.Advance(100 * time.Millisecond)
+	if !flash.Update() {
+		t.Error("Flash should complete after its duration")
+	}
+	if got := slider.currentHandleStyle().GetForeground(); got != blue {
+		t.Errorf("Handle should return to its own color, got %v", got)
+	}
+
+	// Reduced motion skips the flash
+	reduced := NewHandleFlash(slider, lipgloss.Color("#ffffff"), time.Second).
+		WithClock(clock).WithMotion(MotionReduced)
+	if !reduced.Update() || slider.currentHandleStyle().GetForeground() != blue {
+		t.Error("Flash should not run under reduced motion")
+	}
+}
+
+func TestShimmer(t *testing.T) {
+	clock := NewManualClock(time.Time{})
+	slider := New(NewState(WithValue(100)), WithWidth(10), WithHandle(false))
+	shimmer := NewShimmer(slider, lipgloss.NewStyle().Bold(true)).
+		WithPeriod(time.Second).WithClock(clock).WithMotion(MotionFull)
+
+	// Half-way through a sweep the glint is in the middle of the bar
+	clock.Advance(500 * time.Millisecond)
+	if shimmer.Update() {
+		t.Error("Shimmer should run until stopped")
+	}
+	if !slider.filledStyleAt(slider.currentFilledStyle(), 5, 10).GetBold() {
+		t.Error("Expected the glint in the middle of the bar")
+	}
+	if slider.filledStyleAt(slider.currentFilledStyle(), 0, 10).GetBold() {
+		t.Error("Expected no glint at the start of the bar")
+	}
+
+	shimmer.Stop()
+	if !shimmer.Update() {
+		t.Error("Stopped shimmer should complete")
+	}
+	if slider.filledStyleAt(slider.currentFilledStyle(), 5, 10).GetBold() {
+		t.Error("Stopped shimmer should remove its glint")
+	}
+
+	reduced := NewShimmer(slider, lipgloss.NewStyle()).WithMotion(MotionReduced)
+	if !reduced.Update() {
+		t.Error("Shimmer should not run under reduced motion")
+	}
+}
+
+func TestThresholdColors(t *testing.T) {
+	green, yellow, red := lipgloss.Color("#00ff00"), lipgloss.Color("#ffff00"), lipgloss.Color("#ff0000")
+	clock := NewManualClock(time.Time{})
+	state := NewState(WithValue(10), WithMax(100))
+	slider := New(state)
+
+	zones := NewThresholdColors(slider, 100*time.Millisecond,
+		ColorThreshold{At: 0.8, Color: red},
+		ColorThreshold{At: 0, Color: green},
+		ColorThreshold{At: 0.5, Color: yellow},
+	).WithClock(clock).WithMotion(MotionFull)
+
+	if got := slider.currentFilledStyle().GetForeground(); got != green {
+		t.Errorf("Fill should start in the current zone's color, got %v", got)
+	}
+
+	// A tween moving the value runs alongside on the same manager
+	manager := NewAnimationManager(WithClock(clock))
+	manager.Start(state, 90, WithAnimDuration(100*time.Millisecond), WithEasing(Linear))
+	manager.Run(zones)
+	if manager.Count() != 2 {
+		t.Fatalf("Zone coloring should not replace the tween, got %d animations", manager.Count())
+	}
+	if zones.State() != state {
+		t.Error("Zone coloring should report the state it watches")
+	}
+
+	// Running zone coloring again replaces the old one instead of stacking
+	again := NewThresholdColors(slider, 100*time.Millisecond,
+		ColorThreshold{At: 0, Color: green},
+	)
+	manager.Run(again)
+	if _, ok := manager.Get(zones.ID()); ok || manager.Count() != 2 {
+		t.Fatalf("Expected the new zone coloring to replace the old, got %d animations", manager.Count())
+	}
+	manager.Run(zones)
+	if _, ok := manager.Get(again.ID()); ok {
+		t.Fatal("Expected the old zone coloring to be replaced")
+	}
+
+	// Retargeting the tween leaves zone coloring running
+	manager.AnimateTo(state, 90)
+	if manager.Count() != 2 {
+		t.Fatalf("Retargeting should keep zone coloring, got %d animations", manager.Count())
+	}
+
+	for i := 0; i < 30 && manager.IsRunning(); i++ {
+		clock.Advance(10 * time.Millisecond)
+		manager.Update()
+	}
+	if manager.IsRunning() {
+		t.Fatal("Zone coloring should settle once the value stops")
+	}
+	if got := slider.currentFilledStyle().GetForeground(); got != red {
+		t.Errorf("Expected the red zone's color, got %v", got)
+	}
+}
+
+func TestEffects_ReplaceTheSameEffect(t *testing.T) {
+	state := NewState(WithValue(50), WithMax(100))
+	slider := New(state, WithWidth(10))
+	other := New(state, WithWidth(10))
+	manager := NewAnimationManager()
+	red, blue := lipgloss.Color("#ff0000"), lipgloss.Color("#0000ff")
+
+	tween := manager.Start(state, 100, WithAnimDuration(time.Second))
+	fade := manager.Run(NewColorFade(slider, PartFilled, red, time.Second))
+	manager.Run(NewColorFade(other, PartFilled, red, time.Second))
+	manager.Run(NewColorFade(slider, PartEmpty, red, time.Second))
+	if manager.Count() != 4 {
+		t.Fatalf("Effects on other parts or sliders should run together, got %d animations", manager.Count())
+	}
+
+	manager.Run(NewColorFade(slider, PartFilled, blue, time.Second))
+	if _, ok := manager.Get(fade); ok || manager.Count() != 4 {
+		t.Errorf("Expected a second fade of the part to replace the first, got %d animations", manager.Count())
+	}
+
+	flash := manager.Run(NewHandleFlash(slider, red, time.Second))
+	manager.Run(NewHandleFlash(slider, blue, time.Second))
+	if _, ok := manager.Get(flash); ok {
+		t.Error("Expected a second flash to replace the first")
+	}
+
+	shimmer := manager.Run(NewShimmer(slider, lipgloss.NewStyle()))
+	manager.Run(NewShimmer(slider, lipgloss.NewStyle()))
+	if _, ok := manager.Get(shimmer); ok {
+		t.Error("Expected a second shimmer to replace the first")
+	}
+
+	if _, ok := manager.Get(tween); !ok || manager.Count() != 6 {
+		t.Errorf("Effects should leave the value tween alone, got %d animations", manager.Count())
+	}
+}
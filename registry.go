@@ -0,0 +1,311 @@
+package tuslide
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// registry holds named entries in registration order. Names and aliases
+// are matched ignoring case, spaces, hyphens and underscores, so "Double
+// Line", "double-line" and "doubleline" are the same name.
+type registry[T any] struct {
+	mu      sync.RWMutex
+	entries []registryEntry[T]
+	index   map[string]int // Normalized name or alias to entry
+}
+
+type registryEntry[T any] struct {
+	name    string
+	value   T
+	aliases []string
+}
+
+// registryKey normalizes a name or alias for lookup.
+func registryKey(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch r {
+		case ' ', '-', '_':
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// register adds value under name, or replaces the entry already using
+// name, keeping its aliases. New aliases must not name another entry.
+func (r *registry[T]) register(kind, name string, value T, aliases []string) error {
+	if registryKey(name) == "" {
+		return fmt.Errorf("tuslide: %s has no name", kind)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.conflict(kind, name, aliases); err != nil {
+		return fmt.Errorf("tuslide: %w", err)
+	}
+	r.put(name, value, aliases)
+	return nil
+}
+
+// registryItem is an entry to add with registerAll.
+type registryItem[T any] struct {
+	name    string
+	value   T
+	aliases []string
+}
+
+// registryConflict is a name or alias registerAll couldn't add.
+type registryConflict struct {
+	item  int // Index of the item
+	alias int // Index of the alias, or -1 for the name
+	err   error
+}
+
+// registerAll adds items like register, all or none: names and aliases are
+// checked against the registry and each other first, and if any conflicts,
+// nothing is added and the conflicts are returned.
+func (r *registry[T]) registerAll(kind string, items []registryItem[T]) []registryConflict {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var conflicts []registryConflict
+	claimed := make(map[string]int) // Name or alias key to the item using it
+	for i, item := range items {
+		if registryKey(item.name) == "" {
+			conflicts = append(conflicts, registryConflict{i, -1, fmt.Errorf("%s has no name", kind)})
+			continue
+		}
+		if alias, err := r.conflict(kind, item.name, item.aliases); err != nil {
+			conflicts = append(conflicts, registryConflict{i, alias, err})
+			continue
+		}
+
+		key := registryKey(item.name)
+		if j, ok := claimed[key]; ok {
+			conflicts = append(conflicts, registryConflict{i, -1,
+				fmt.Errorf("%s name %q is also used by %q", kind, item.name, items[j].name)})
+			continue
+		}
+		claimed[key] = i
+		for a, alias := range item.aliases {
+			key := registryKey(alias)
+			if key == "" {
+				continue
+			}
+			if j, ok := claimed[key]; ok && j != i {
+				conflicts = append(conflicts, registryConflict{i, a,
+					fmt.Errorf("%s alias %q is taken by %q", kind, alias, items[j].name)})
+				continue
+			}
+			claimed[key] = i
+		}
+	}
+	if len(conflicts) > 0 {
+		return conflicts
+	}
+
+	for _, item := range items {
+		r.put(item.name, item.value, item.aliases)
+	}
+	return nil
+}
+
+// conflict returns why name and aliases can't be registered, with the
+// index of the alias at fault, or -1 for the name. Callers hold the write
+// lock.
+func (r *registry[T]) conflict(kind, name string, aliases []string) (int, error) {
+	idx, ok := r.index[registryKey(name)]
+	if ok && registryKey(r.entries[idx].name) != registryKey(name) {
+		return -1, fmt.Errorf("%s name %q is an alias of %q", kind, name, r.entries[idx].name)
+	}
+	for i, alias := range aliases {
+		if other, taken := r.index[registryKey(alias)]; taken && (!ok || other != idx) {
+			return i, fmt.Errorf("%s alias %q is taken by %q", kind, alias, r.entries[other].name)
+		}
+	}
+	return 0, nil
+}
+
+// put adds or replaces the entry for name after conflict found no
+// problems. Callers hold the write lock.
+func (r *registry[T]) put(name string, value T, aliases []string) {
+	if r.index == nil {
+		r.index = make(map[string]int)
+	}
+
+	key := registryKey(name)
+	idx, ok := r.index[key]
+	if ok {
+		r.entries[idx].name = name
+		r.entries[idx].value = value
+	} else {
+		idx = len(r.entries)
+		r.entries = append(r.entries, registryEntry[T]{name: name, value: value})
+		r.index[key] = idx
+	}
+	for _, alias := range aliases {
+		r.addAlias(idx, alias)
+	}
+}
+
+// alias adds an alias for the entry found by name.
+func (r *registry[T]) alias(kind, alias, name string) error {
+	key := registryKey(alias)
+	if key == "" {
+		return fmt.Errorf("tuslide: empty %s alias", kind)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	idx, ok := r.index[registryKey(name)]
+	if !ok {
+		return fmt.Errorf("tuslide: unknown %s %q", kind, name)
+	}
+	if other, taken := r.index[key]; taken && other != idx {
+		return fmt.Errorf("tuslide: %s alias %q is taken by %q", kind, alias, r.entries[other].name)
+	}
+	r.addAlias(idx, alias)
+	return nil
+}
+
+// addAlias records alias for entry idx unless it already matches it.
+// Callers hold the write lock.
+func (r *registry[T]) addAlias(idx int, alias string) {
+	key := registryKey(alias)
+	if key == "" {
+		return
+	}
+	if _, ok := r.index[key]; ok {
+		return
+	}
+	r.index[key] = idx
+	r.entries[idx].aliases = append(r.entries[idx].aliases, alias)
+}
+
+// lookup returns the entry found by name or alias.
+func (r *registry[T]) lookup(name string) (T, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if idx, ok := r.index[registryKey(name)]; ok {
+		return r.entries[idx].value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// names returns the entries' names in registration order.
+func (r *registry[T]) names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, len(r.entries))
+	for i, e := range r.entries {
+		names[i] = e.name
+	}
+	return names
+}
+
+// aliases returns the aliases of the entry found by name or alias.
+func (r *registry[T]) aliases(name string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if idx, ok := r.index[registryKey(name)]; ok {
+		return append([]string(nil), r.entries[idx].aliases...)
+	}
+	return nil
+}
+
+var (
+	styleRegistry     registry[SliderStyle]
+	symbolSetRegistry registry[SymbolSet]
+)
+
+// Aliases for built-in styles and symbol sets whose constructor or
+// variable isn't named after them, e.g. StyleProgressDownload ("Download").
+var (
+	builtinStyleAliases = map[string][]string{
+		"Download":     {"Progress Download"},
+		"Upload":       {"Progress Upload"},
+		"Loading":      {"Progress Loading"},
+		"Installation": {"Progress Installation"},
+		"Battery":      {"Progress Battery"},
+		"Equalizer":    {"Vertical Equalizer"},
+	}
+	builtinSymbolSetAliases = map[string][]string{
+		"Equalizer": {"Vertical Equalizer"},
+	}
+)
+
+func init() {
+	for _, style := range AllStyles() {
+		if err := RegisterStyle(style, builtinStyleAliases[style.Name]...); err != nil {
+			panic(err)
+		}
+	}
+	for _, set := range AllSymbolSets() {
+		if err := RegisterSymbolSet(set, builtinSymbolSetAliases[set.Name]...); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterStyle adds a style to the registry under its Name, or replaces
+// the style with the same name. Aliases are other names it can be looked
+// up by. It fails if the style has no name, or an alias already names
+// another style. The registry starts with AllStyles.
+func RegisterStyle(style SliderStyle, aliases ...string) error {
+	return styleRegistry.register("style", style.Name, style, aliases)
+}
+
+// AliasStyle adds another name for a registered style.
+func AliasStyle(alias, name string) error {
+	return styleRegistry.alias("style", alias, name)
+}
+
+// LookupStyle returns the registered style with a name or alias, ignoring
+// case, spaces, hyphens and underscores, e.g. from a config file:
+// "ocean", "Double Line" and "segmented-stars" all work.
+func LookupStyle(name string) (SliderStyle, bool) {
+	return styleRegistry.lookup(name)
+}
+
+// StyleNames returns the names of the registered styles, in the order
+// they were registered.
+func StyleNames() []string {
+	return styleRegistry.names()
+}
+
+// StyleAliases returns the aliases of a registered style.
+func StyleAliases(name string) []string {
+	return styleRegistry.aliases(name)
+}
+
+// RegisterSymbolSet adds a symbol set to the registry under its Name, like
+// RegisterStyle. The registry starts with AllSymbolSets.
+func RegisterSymbolSet(set SymbolSet, aliases ...string) error {
+	return symbolSetRegistry.register("symbol set", set.Name, set, aliases)
+}
+
+// AliasSymbolSet adds another name for a registered symbol set.
+func AliasSymbolSet(alias, name string) error {
+	return symbolSetRegistry.alias("symbol set", alias, name)
+}
+
+// LookupSymbolSet returns the registered symbol set with a name or alias,
+// matched like LookupStyle.
+func LookupSymbolSet(name string) (SymbolSet, bool) {
+	return symbolSetRegistry.lookup(name)
+}
+
+// SymbolSetNames returns the names of the registered symbol sets, in the
+// order they were registered.
+func SymbolSetNames() []string {
+	return symbolSetRegistry.names()
+}
+
+// SymbolSetAliases returns the aliases of a registered symbol set.
+func SymbolSetAliases(name string) []string {
+	return symbolSetRegistry.aliases(name)
+}
@@ -0,0 +1,283 @@
+package tuslide
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+var updateDocs = flag.Bool("update", false, "regenerate STYLEPRESETS.md from the style registry")
+
+func TestLookupStyle(t *testing.T) {
+	for _, name := range []string{"Ocean", "ocean", "Double Line", "double-line", "DOUBLE_LINE",
+		"doubleline", "progress download", "Vertical Equalizer"} {
+		if _, ok := LookupStyle(name); !ok {
+			t.Errorf("Expected to find %q", name)
+		}
+	}
+
+	if style, _ := LookupStyle("segmented-stars"); style.Name != "Segmented Stars" {
+		t.Errorf("Expected Segmented Stars, got %q", style.Name)
+	}
+	if _, ok := LookupStyle("Fire"); ok {
+		t.Error("Unknown styles should not be found")
+	}
+
+	if set, ok := LookupSymbolSet("ascii"); !ok || set != SymbolSetASCII {
+		t.Errorf("Expected the ASCII symbol set, got %+v", set)
+	}
+}
+
+func TestStyleRegistry_Builtins(t *testing.T) {
+	names := StyleNames()
+	all := AllStyles()
+	if len(names) < len(all) {
+		t.Fatalf("Expected at least %d styles, got %d", len(all), len(names))
+	}
+	for i, style := range all {
+		if names[i] != style.Name {
+			t.Errorf("Expected %q at %d, got %q", style.Name, i, names[i])
+		}
+	}
+
+	sets := SymbolSetNames()
+	for i, set := range AllSymbolSets() {
+		if sets[i] != set.Name {
+			t.Errorf("Expected symbol set %q at %d, got %q", set.Name, i, sets[i])
+		}
+	}
+
+	if aliases := StyleAliases("download"); !reflect.DeepEqual(aliases, []string{"Progress Download"}) {
+		t.Errorf("Unexpected aliases %q", aliases)
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	var r registry[SliderStyle]
+
+	fire := SliderStyle{Name: "Fire", Symbols: DefaultSymbols()}
+	if err := r.register("style", fire.Name, fire, []string{"Flames"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := r.lookup("flames"); !ok || got.Name != "Fire" {
+		t.Error("Expected to find the style by alias")
+	}
+
+	// Registering the same name replaces the style and keeps its aliases
+	fire.Symbols = ASCIISymbols()
+	if err := r.register("style", "FIRE", fire, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := r.lookup("Flames"); got.Symbols != ASCIISymbols() {
+		t.Error("Expected the replaced style")
+	}
+	if names := r.names(); !reflect.DeepEqual(names, []string{"FIRE"}) {
+		t.Errorf("Expected one style, got %q", names)
+	}
+
+	if err := r.register("style", "", fire, nil); err == nil {
+		t.Error("Expected an error for a style without a name")
+	}
+	if err := r.register("style", "Ice", fire, []string{"fire"}); err == nil {
+		t.Error("Expected an error for an alias naming another style")
+	}
+	if err := r.register("style", "flames", fire, nil); err == nil {
+		t.Error("Expected an error for a name that is another style's alias")
+	}
+	if _, ok := r.lookup("Ice"); ok {
+		t.Error("Failed registrations should not add styles")
+	}
+
+	if err := r.alias("style", "Blaze", "fire"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.alias("style", "Frost", "Ice"); err == nil {
+		t.Error("Expected an error aliasing an unknown style")
+	}
+	if aliases := r.aliases("blaze"); !reflect.DeepEqual(aliases, []string{"Flames", "Blaze"}) {
+		t.Errorf("Unexpected aliases %q", aliases)
+	}
+}
+
+func TestRegistry_RegisterAll(t *testing.T) {
+	var r registry[SymbolSet]
+	if err := r.register("symbol set", "Dots", SymbolSetASCII, []string{"Points"}); err != nil {
+		t.Fatal(err)
+	}
+
+	conflicts := r.registerAll("symbol set", []registryItem[SymbolSet]{
+		{"Bars", SymbolSetASCII, []string{"Shared"}},
+		{"Blocks", SymbolSetASCII, []string{"Cubes", "shared"}},
+		{"Lines", SymbolSetASCII, []string{"points"}},
+	})
+	want := []registryConflict{{item: 1, alias: 1}, {item: 2, alias: 0}}
+	if len(conflicts) != len(want) {
+		t.Fatalf("Expected %d conflicts, got %v", len(want), conflicts)
+	}
+	for i, c := range conflicts {
+		if c.item != want[i].item || c.alias != want[i].alias || c.err == nil {
+			t.Errorf("Expected conflict %+v, got %+v", want[i], c)
+		}
+	}
+	if names := r.names(); !reflect.DeepEqual(names, []string{"Dots"}) {
+		t.Errorf("Conflicts should register nothing, got %q", names)
+	}
+
+	if conflicts := r.registerAll("symbol set", []registryItem[SymbolSet]{
+		{"Bars", SymbolSetASCII, []string{"Shared"}},
+		{"dots", SymbolSetASCII, []string{"points"}},
+	}); conflicts != nil {
+		t.Fatalf("Unexpected conflicts %v", conflicts)
+	}
+	if names := r.names(); !reflect.DeepEqual(names, []string{"dots", "Bars"}) {
+		t.Errorf("Unexpected names %q", names)
+	}
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	var r registry[SymbolSet]
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("Set %d", i)
+			if err := r.register("symbol set", name, SymbolSetASCII, nil); err != nil {
+				t.Error(err)
+			}
+			r.lookup(name)
+			r.names()
+		}(i)
+	}
+	wg.Wait()
+
+	if len(r.names()) != 8 {
+		t.Errorf("Expected 8 symbol sets, got %d", len(r.names()))
+	}
+}
+
+// TestStylePresetsDoc checks that STYLEPRESETS.md matches the registry.
+// Run with -update to regenerate it.
+func TestStylePresetsDoc(t *testing.T) {
+	doc := stylePresetsDoc()
+	if *updateDocs {
+		if err := os.WriteFile("STYLEPRESETS.md", []byte(doc), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	current, err := os.ReadFile("STYLEPRESETS.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(current) != doc {
+		t.Error("STYLEPRESETS.md is out of date; run go test -run TestStylePresetsDoc -update")
+	}
+}
+
+// stylePresetsDoc generates STYLEPRESETS.md from the built-in entries of
+// the registry, previewing each at half.
+func stylePresetsDoc() string {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(profile)
+
+	preview := func(opts ...SliderOption) string {
+		view := New(NewState(WithValue(50)), append([]SliderOption{WithWidth(12)}, opts...)...).View()
+		return "`" + strings.ReplaceAll(view, "|", `\|`) + "`"
+	}
+	aliases := func(list []string) string {
+		return strings.Join(list, ", ")
+	}
+
+	var b strings.Builder
+	b.WriteString(stylePresetsHeader)
+
+	styles := AllStyles()
+	fmt.Fprintf(&b, "\n## Styles\n\nThere are %d built-in styles.\n\n", len(styles))
+	b.WriteString("| Name | Aliases | Preview |\n|------|---------|---------|\n")
+	for _, style := range styles {
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", style.Name, aliases(StyleAliases(style.Name)),
+			preview(WithStyle(style)))
+	}
+
+	b.WriteString(symbolSetsHeader)
+	b.WriteString("| Name | Aliases | Preview |\n|------|---------|---------|\n")
+	for _, set := range AllSymbolSets() {
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", set.Name, aliases(SymbolSetAliases(set.Name)),
+			preview(WithSymbolSet(set)))
+	}
+
+	b.WriteString(stylePresetsFooter)
+	return b.String()
+}
+
+const stylePresetsHeader = `# Style Presets
+
+<!-- Generated from the style registry by TestStylePresetsDoc; run
+     go test -run TestStylePresetsDoc -update after changing styles. -->
+
+Apply a predefined style with ` + "`tuslide.WithStyle()`" + `, or look it up by name,
+e.g. from a config file:
+
+` + "```go" + `
+slider := tuslide.New(state, tuslide.WithStyle(tuslide.StyleNeon()))
+
+if style, ok := tuslide.LookupStyle(cfg.Style); ok {
+    slider = tuslide.New(state, tuslide.WithStyle(style))
+}
+` + "```" + `
+
+Names and aliases match ignoring case, spaces, hyphens and underscores, so
+"Double Line", "double-line" and "doubleline" find the same style. Each
+style's constructor is ` + "`Style`" + ` followed by its name, or its alias if it
+has one, without spaces: ` + "`StyleDoubleLine()`, `StyleProgressDownload()`" + `.
+`
+
+const symbolSetsHeader = `
+## Symbol Sets
+
+Symbol sets only change the characters. Look them up with
+` + "`tuslide.LookupSymbolSet()`" + ` and apply them with ` + "`tuslide.WithSymbolSet()`" + `.
+Each is a variable named ` + "`SymbolSet`" + ` followed by its name, or its alias if
+it has one, without spaces: ` + "`SymbolSetDoubleLine`" + `.
+
+`
+
+const stylePresetsFooter = `
+## Custom Styles
+
+Create your own style by combining symbols and colors, and register it to
+make it available by name:
+
+` + "```go" + `
+style := tuslide.SliderStyle{
+    Name: "Fire",
+    Symbols: tuslide.Symbols{
+        Filled: "█",
+        Empty:  "░",
+        Handle: "●",
+    },
+    FilledStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B")),
+    EmptyStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("#4A4A4A")),
+    HandleStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")),
+}
+
+if err := tuslide.RegisterStyle(style, "flames"); err != nil {
+    log.Fatal(err)
+}
+` + "```" + `
+
+Styles can also be written as JSON, YAML or TOML theme files and loaded
+with ` + "`tuslide.RegisterThemeDir()`" + `; see [Theme Files](README.md#theme-files).
+
+See the [showcase example](examples/showcase/main.go) for a live demo of all styles.
+`
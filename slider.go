@@ -204,6 +204,9 @@ type Slider struct {
 	segmentCount int // Number of segments (0 = auto based on width)
 	segmentGap   int // Gap between segments in characters
 
+	// Colors blended across the filled track (zero for none)
+	gradient Gradient
+
 	// Styles
 	filledStyle lipgloss.Style
 	emptyStyle  lipgloss.Style
@@ -211,6 +214,61 @@ type Slider struct {
 	labelStyle  lipgloss.Style
 	valueStyle  lipgloss.Style
 	borderStyle_ lipgloss.Style
+
+	// Hover state (set by MouseState)
+	hovered          bool
+	hoverValue       float64
+	hoverTooltip     bool
+	hoverFilledStyle lipgloss.Style
+	hoverEmptyStyle  lipgloss.Style
+	hoverHandleStyle lipgloss.Style
+	tooltipStyle     lipgloss.Style
+
+	// Per-frame overrides written by presentation animators
+	fx *Presentation
+
+	// Indeterminate mode: the value is ignored and the busy span is drawn
+	// filled instead, as fractions of the track (see Marquee)
+	indeterminate bool
+	busyStart     float64
+	busyEnd       float64
+
+	// Disabled sliders are dimmed and ignore input; read-only sliders
+	// ignore input but look as usual
+	disabled      bool
+	readOnly      bool
+	disabledStyle lipgloss.Style
+
+	// What the terminal can display (see TerminalCapabilities)
+	terminal TerminalCapabilities
+
+	// Text the last view was wrapped in, such as an AccessibleSlider's
+	// cues; nil for a plain View
+	decorate func(view string) string
+
+	// Where the last view put the track and handle, and the widest
+	// tooltip; nil until measured or once stale (see invalidateLayout)
+	laidOut  *sliderLayout
+	tipWidth *tooltipWidth
+}
+
+// sliderLayout is where a view put the track and handle.
+type sliderLayout struct {
+	key           layoutKey
+	track, handle Rect
+}
+
+// layoutKey holds what moves the track and handle between views without
+// going through a Slider method: the state's value and bounds.
+type layoutKey struct {
+	state           *SliderState
+	value, min, max float64
+}
+
+// tooltipWidth is the widest tooltip for the bounds it was measured with.
+type tooltipWidth struct {
+	min, max float64
+	width    int
 }
 
 // SliderOption is a functional option for configuring a Slider.
@@ -258,11 +316,21 @@ func New(state *SliderState, opts ...SliderOption) *Slider {
 		labelStyle:   lipgloss.NewStyle(),
 		valueStyle:   lipgloss.NewStyle(),
 		borderStyle_: lipgloss.NewStyle(),
+		// Hover styles (unset properties fall back to the regular styles)
+		hoverFilledStyle: lipgloss.NewStyle(),
+		hoverEmptyStyle:  lipgloss.NewStyle(),
+		hoverHandleStyle: lipgloss.NewStyle(),
+		tooltipStyle:     lipgloss.NewStyle().Faint(true),
+		disabledStyle:    lipgloss.NewStyle().Faint(true),
+		fx:               newPresentation(),
+		busyEnd:          DefaultMarqueeWidth,
+		terminal:         GlobalTerminalCapabilities(),
 	}
 
 	for _, opt := range opts {
 		opt(s)
 	}
+	s.adaptToTerminal()
 
 	return s
 }
@@ -477,6 +545,103 @@ func WithSegmentGap(gap int) SliderOption {
 	}
 }
 
+// Gradient blends the filled track from one color at its start to another
+// at its end, in the L*a*b* space (see BlendColors).
+type Gradient struct {
+	From lipgloss.TerminalColor
+	To   lipgloss.TerminalColor
+}
+
+// IsZero reports whether the gradient is unset.
+func (g Gradient) IsZero() bool {
+	return g.From == nil && g.To == nil
+}
+
+// at returns the color of filled cell i of n.
+func (g Gradient) at(i, n int) lipgloss.TerminalColor {
+	if n <= 1 {
+		return g.From
+	}
+	return BlendColors(g.From, g.To, float64(i)/float64(n-1))
+}
+
+// WithGradient colors the filled track with a gradient from one color to
+// another, in place of the filled style's foreground.
+func WithGradient(from, to lipgloss.TerminalColor) SliderOption {
+	return func(s *Slider) {
+		s.gradient = Gradient{From: from, To: to}
+	}
+}
+
+// WithIndeterminate starts the slider in indeterminate (busy) mode, for
+// progress with an unknown total. Run a Marquee to animate it.
+func WithIndeterminate(enabled bool) SliderOption {
+	return func(s *Slider) {
+		s.indeterminate = enabled
+	}
+}
+
+// WithHoverFilledStyle sets the filled style used while the pointer is over the track.
+// Properties left unset fall back to the regular filled style.
+func WithHoverFilledStyle(style lipgloss.Style) SliderOption {
+	return func(s *Slider) {
+		s.hoverFilledStyle = style
+	}
+}
+
+// WithHoverEmptyStyle sets the empty style used while the pointer is over the track.
+// Properties left unset fall back to the regular empty style.
+func WithHoverEmptyStyle(style lipgloss.Style) SliderOption {
+	return func(s *Slider) {
+		s.hoverEmptyStyle = style
+	}
+}
+
+// WithHoverHandleStyle sets the handle style used while the pointer is over the track.
+// Properties left unset fall back to the regular handle style.
+func WithHoverHandleStyle(style lipgloss.Style) SliderOption {
+	return func(s *Slider) {
+		s.hoverHandleStyle = style
+	}
+}
+
+// WithHoverTooltip shows a preview of the value under the pointer while hovering.
+func WithHoverTooltip(show bool) SliderOption {
+	return func(s *Slider) {
+		s.hoverTooltip = show
+	}
+}
+
+// WithTooltipStyle sets the style for the hover tooltip.
+func WithTooltipStyle(style lipgloss.Style) SliderOption {
+	return func(s *Slider) {
+		s.tooltipStyle = style
+	}
+}
+
+// WithDisabled creates the slider disabled (see SetDisabled).
+func WithDisabled(disabled bool) SliderOption {
+	return func(s *Slider) {
+		s.disabled = disabled
+	}
+}
+
+// WithReadOnly creates the slider read-only (see SetReadOnly).
+func WithReadOnly(readOnly bool) SliderOption {
+	return func(s *Slider) {
+		s.readOnly = readOnly
+	}
+}
+
+// WithDisabledStyle sets the style laid over every part of a disabled
+// slider. Unset properties fall back to the regular styles; the default
+// is faint.
+func WithDisabledStyle(style lipgloss.Style) SliderOption {
+	return func(s *Slider) {
+		s.disabledStyle = style
+	}
+}
+
 // WithStyle applies a predefined SliderStyle to the slider.
 func WithStyle(style SliderStyle) SliderOption {
 	return func(s *Slider) {
@@ -487,6 +652,27 @@ func WithStyle(style SliderStyle) SliderOption {
 		s.labelStyle = style.LabelStyle
 		s.valueStyle = style.ValueStyle
 		s.segmented = style.Segmented
+		s.applyStyleExtras(style)
+	}
+}
+
+// applyStyleExtras applies the optional settings of a SliderStyle that are
+// set, keeping the slider's own for the rest.
+func (s *Slider) applyStyleExtras(style SliderStyle) {
+	if style.SegmentCount > 0 {
+		s.segmentCount = style.SegmentCount
+	}
+	if style.SegmentGap > 0 {
+		s.segmentGap = style.SegmentGap
+	}
+	if style.Border != BorderNone {
+		s.borderStyle = style.Border
+	}
+	if style.BorderColor != "" {
+		s.borderColor = style.BorderColor
+	}
+	if !style.Gradient.IsZero() {
+		s.gradient = style.Gradient
 	}
 }
 
@@ -502,20 +688,287 @@ func (s *Slider) State() *SliderState {
 	return s.state
 }
 
+// SetIndeterminate switches indeterminate (busy) mode on or off. While
+// on, the slider ignores its state's value and draws the busy span
+// filled, without a handle. Use Marquee.Settle to switch off smoothly.
+func (s *Slider) SetIndeterminate(enabled bool) {
+	s.indeterminate = enabled
+	s.invalidateLayout()
+}
+
+// IsIndeterminate reports whether the slider is in indeterminate mode.
+func (s *Slider) IsIndeterminate() bool {
+	return s.indeterminate
+}
+
+// SetDisabled disables or enables the slider. A disabled slider is drawn
+// with the disabled style, ignores the mouse and keys, and is skipped by
+// SliderGroup focus traversal. Its state can still be set directly.
+func (s *Slider) SetDisabled(disabled bool) {
+	s.disabled = disabled
+	s.invalidateLayout()
+}
+
+// IsDisabled reports whether the slider is disabled.
+func (s *Slider) IsDisabled() bool {
+	return s.disabled
+}
+
+// SetReadOnly makes the slider read-only or editable. A read-only slider
+// looks as usual and can take focus, so its value can still be read, but
+// ignores input that would change it.
+func (s *Slider) SetReadOnly(readOnly bool) {
+	s.readOnly = readOnly
+	s.invalidateLayout()
+}
+
+// IsReadOnly reports whether the slider is read-only.
+func (s *Slider) IsReadOnly() bool {
+	return s.readOnly
+}
+
+// Editable reports whether input may change the slider's value: it is
+// neither disabled nor read-only.
+func (s *Slider) Editable() bool {
+	return !s.disabled && !s.readOnly
+}
+
+// SetBusySpan sets the part of the track drawn filled in indeterminate
+// mode, from start to end as fractions of the track. Parts outside 0-1
+// are clipped, so a span can slide in and out of view.
+func (s *Slider) SetBusySpan(start, end float64) {
+	s.busyStart, s.busyEnd = start, end
+}
+
+// BusySpan returns the part of the track drawn filled in indeterminate mode.
+func (s *Slider) BusySpan() (start, end float64) {
+	return s.busyStart, s.busyEnd
+}
+
+// busyCells returns the range [from, to) of n cells covered by the busy
+// span. A span that overlaps the track covers at least one cell.
+func (s *Slider) busyCells(n int) (from, to int) {
+	if n <= 0 || s.busyEnd <= 0 || s.busyStart >= 1 || s.busyEnd <= s.busyStart {
+		return 0, 0
+	}
+
+	from = int(math.Round(Clamp(s.busyStart, 0, 1) * float64(n)))
+	to = int(math.Round(Clamp(s.busyEnd, 0, 1) * float64(n)))
+	if to <= from {
+		from = min(from, n-1)
+		to = from + 1
+	}
+	return from, to
+}
+
 // SetState updates the slider's state.
 func (s *Slider) SetState(state *SliderState) {
 	s.state = state
+	s.invalidateLayout()
+}
+
+// SetHover marks the slider as hovered with the value under the pointer.
+// The value is snapped to the nearest one the track can show, which is the
+// value a click there would set. MouseState calls this automatically while
+// handling mouse events.
+func (s *Slider) SetHover(value float64) {
+	s.hovered = true
+	s.hoverValue = s.snapToTrack(value)
+}
+
+// ClearHover removes the hover state.
+func (s *Slider) ClearHover() {
+	s.hovered = false
+}
+
+// IsHovered returns true if the pointer is over the slider's track.
+func (s *Slider) IsHovered() bool {
+	return s.hovered
+}
+
+// HoverValue returns the value under the pointer while hovered.
+func (s *Slider) HoverValue() float64 {
+	return s.hoverValue
+}
+
+// Presentation returns the slider's per-frame style overrides, written by
+// presentation animators such as ColorFade and Shimmer.
+func (s *Slider) Presentation() *Presentation {
+	return s.fx
+}
+
+// baseStyle returns the style of part for the current hover and disabled
+// state, without presentation overrides.
+func (s *Slider) baseStyle(part StylePart) lipgloss.Style {
+	style, hover := s.filledStyle, s.hoverFilledStyle
+	switch part {
+	case PartEmpty:
+		style, hover = s.emptyStyle, s.hoverEmptyStyle
+	case PartHandle:
+		style, hover = s.handleStyle, s.hoverHandleStyle
+	}
+
+	if s.disabled {
+		return s.disabledStyle.Inherit(style)
+	}
+	if s.hovered {
+		return hover.Inherit(style)
+	}
+	return style
+}
+
+// partStyle returns the style part is drawn with, including presentation
+// overrides.
+func (s *Slider) partStyle(part StylePart) lipgloss.Style {
+	return s.fx.Style(part).Inherit(s.baseStyle(part))
+}
+
+// currentFilledStyle returns the filled style for the current frame.
+func (s *Slider) currentFilledStyle() lipgloss.Style {
+	return s.partStyle(PartFilled)
+}
+
+// currentEmptyStyle returns the empty style for the current frame.
+func (s *Slider) currentEmptyStyle() lipgloss.Style {
+	return s.partStyle(PartEmpty)
+}
+
+// currentHandleStyle returns the handle style for the current frame.
+func (s *Slider) currentHandleStyle() lipgloss.Style {
+	return s.partStyle(PartHandle)
+}
+
+// textStyle returns style for the label or value, dimmed while disabled.
+func (s *Slider) textStyle(style lipgloss.Style) lipgloss.Style {
+	if s.disabled {
+		return s.disabledStyle.Inherit(style)
+	}
+	return style
+}
+
+// filledStyleAt returns the style for filled cell i of n: the glint style
+// where a Shimmer's glint passes, otherwise filled in the gradient's color
+// unless an animation is coloring the track.
+func (s *Slider) filledStyleAt(filled lipgloss.Style, i, n int) lipgloss.Style {
+	if s.fx.glintAt(i, n) {
+		return s.fx.glintStyle.Inherit(filled)
+	}
+	if s.gradient.IsZero() {
+		return filled
+	}
+	if _, unset := s.fx.Style(PartFilled).GetForeground().(lipgloss.NoColor); !unset {
+		return filled
+	}
+	return filled.Foreground(s.gradient.at(i, n))
+}
+
+// tooltip returns the hover tooltip padded to tooltipWidth, so the layout
+// doesn't shift as the pointer enters, leaves or moves along the track. It
+// is blank while not hovered and "" if no tooltip should be shown. Sliders
+// that ignore input don't preview values.
+func (s *Slider) tooltip() string {
+	width := s.tooltipWidth()
+	if width == 0 {
+		return ""
+	}
+	tip := ""
+	if s.hovered {
+		tip = s.renderTooltip(s.hoverValue)
+	}
+	return tip + strings.Repeat(" ", max(width-lipgloss.Width(tip), 0))
+}
+
+// tooltipWidth returns the width of the widest tooltip the track can show,
+// or 0 if no tooltip is shown.
+func (s *Slider) tooltipWidth() int {
+	if !s.hoverTooltip || !s.Editable() {
+		return 0
+	}
+	lo, hi := s.state.Min(), s.state.Max()
+	if c := s.tipWidth; c != nil && c.min == lo && c.max == hi {
+		return c.width
+	}
+
+	n := s.trackSteps()
+	width := lipgloss.Width(s.renderTooltip(lo))
+	for k := 1; k <= n; k++ {
+		v := lo + float64(k)/float64(n)*s.state.Range()
+		width = max(width, lipgloss.Width(s.renderTooltip(v)))
+	}
+	s.tipWidth = &tooltipWidth{lo, hi, width}
+	return width
+}
+
+// renderTooltip renders the tooltip for value.
+func (s *Slider) renderTooltip(value float64) string {
+	return s.tooltipStyle.Render("(" + s.formatNumber(value) + ")")
 }
 
 // View renders the slider and returns the string representation.
 // This is compatible with Bubble Tea's View method pattern.
 func (s *Slider) View() string {
+	return s.present(nil)
+}
+
+// present renders the slider wrapped by decorate, which may add text
+// around or beside the view, and records where the track and handle are.
+// TrackRect and HandleRect account for decorate until the next view.
+func (s *Slider) present(decorate func(string) string) string {
+	s.decorate = decorate
+	view, zones := scanZones(s.render(true))
+	s.laidOut = &sliderLayout{key: s.layoutKey(), track: zones[trackZone], handle: zones[handleZone]}
+	return view
+}
+
+// TrackRect returns the position and size of the track relative to the
+// slider's rendered origin (the top-left cell of View), accounting for
+// labels, values, the border and its title, and the cues of an
+// AccessibleSlider if that rendered it last.
+// Add the origin of the rendered view to get screen coordinates.
+func (s *Slider) TrackRect() Rect {
+	track, _ := s.layout()
+	return track
+}
+
+// HandleRect returns the position and size of the handle relative to the
+// slider's rendered origin. The rectangle is empty when no handle is drawn.
+func (s *Slider) HandleRect() Rect {
+	_, handle := s.layout()
+	return handle
+}
+
+// layout returns where the track and handle are, as the last view put
+// them. If the value or bounds changed since, or the slider was changed
+// through a method that can move them, it renders the slider again.
+func (s *Slider) layout() (track, handle Rect) {
+	if s.laidOut == nil || s.laidOut.key != s.layoutKey() {
+		_, zones := scanZones(s.render(true))
+		s.laidOut = &sliderLayout{key: s.layoutKey(), track: zones[trackZone], handle: zones[handleZone]}
+	}
+	return s.laidOut.track, s.laidOut.handle
+}
+
+// layoutKey returns the key of the current layout.
+func (s *Slider) layoutKey() layoutKey {
+	return layoutKey{s.state, s.state.Value(), s.state.Min(), s.state.Max()}
+}
+
+// invalidateLayout drops the measured layout and tooltip width, after a
+// change that can move the track or handle or resize the tooltip.
+func (s *Slider) invalidateLayout() {
+	s.laidOut = nil
+	s.tipWidth = nil
+}
+
+// render builds the slider view. When markZones is true, the track and
+// handle are wrapped in zone markers so their final positions can be located.
+func (s *Slider) render(markZones bool) string {
 	var content string
 	switch s.orientation {
 	case Vertical:
-		content = s.renderVertical()
+		content = s.renderVertical(markZones)
 	default:
-		content = s.renderHorizontal()
+		content = s.renderHorizontal(markZones)
 	}
 
 	// Apply border if configured
@@ -523,6 +976,9 @@ func (s *Slider) View() string {
 		content = s.applyBorder(content)
 	}
 
+	if s.decorate != nil {
+		content = s.decorate(content)
+	}
 	return content
 }
 
@@ -541,6 +997,9 @@ func (s *Slider) applyBorder(content string) string {
 	default:
 		return content
 	}
+	if !s.terminal.Unicode {
+		border = lipgloss.ASCIIBorder()
+	}
 
 	style := lipgloss.NewStyle().
 		Border(border).
@@ -561,16 +1020,19 @@ func (s *Slider) String() string {
 }
 
 // renderHorizontal renders a horizontal slider.
-func (s *Slider) renderHorizontal() string {
-	track := s.buildHorizontalTrack()
+func (s *Slider) renderHorizontal(markZones bool) string {
+	track := s.buildHorizontalTrack(markZones)
+	if markZones {
+		track = markZone(trackZone, track)
+	}
 	label := ""
 	value := ""
 
 	if s.label != "" {
-		label = s.labelStyle.Render(s.label)
+		label = s.textStyle(s.labelStyle).Render(s.label)
 	}
 	if s.showValue {
-		value = s.valueStyle.Render(s.formatValue())
+		value = s.textStyle(s.valueStyle).Render(s.formatValue())
 	}
 
 	// Resolve collisions if enabled
@@ -612,31 +1074,34 @@ func (s *Slider) renderHorizontal() string {
 	midLine.WriteString(leftPad)
 	midLine.WriteString(track)
 	midLine.WriteString(rightPad)
+	if tip := s.tooltip(); tip != "" {
+		midLine.WriteString(" " + tip)
+	}
 
 	// Build top line
 	if labelPos == LabelTop {
-		topLine.WriteString(strings.Repeat(" ", runewidth.StringWidth(leftPad)))
+		topLine.WriteString(strings.Repeat(" ", lipgloss.Width(leftPad)))
 		topLine.WriteString(label)
 	}
 	if s.showValue && valuePos == ValueTop {
 		if topLine.Len() > 0 {
 			topLine.WriteString(" ")
 		} else {
-			topLine.WriteString(strings.Repeat(" ", runewidth.StringWidth(leftPad)))
+			topLine.WriteString(strings.Repeat(" ", lipgloss.Width(leftPad)))
 		}
 		topLine.WriteString(value)
 	}
 
 	// Build bottom line
 	if labelPos == LabelBottom {
-		bottomLine.WriteString(strings.Repeat(" ", runewidth.StringWidth(leftPad)))
+		bottomLine.WriteString(strings.Repeat(" ", lipgloss.Width(leftPad)))
 		bottomLine.WriteString(label)
 	}
 	if s.showValue && valuePos == ValueBottom {
 		if bottomLine.Len() > 0 {
 			bottomLine.WriteString(" ")
 		} else {
-			bottomLine.WriteString(strings.Repeat(" ", runewidth.StringWidth(leftPad)))
+			bottomLine.WriteString(strings.Repeat(" ", lipgloss.Width(leftPad)))
 		}
 		bottomLine.WriteString(value)
 	}
@@ -697,9 +1162,12 @@ func (s *Slider) resolveCollision(labelPos LabelPosition, valuePos ValuePosition
 }
 
 // buildHorizontalTrack builds just the horizontal slider track.
-func (s *Slider) buildHorizontalTrack() string {
+func (s *Slider) buildHorizontalTrack(markHandle bool) string {
 	if s.segmented {
-		return s.buildSegmentedHorizontalTrack()
+		return s.buildSegmentedHorizontalTrack(markHandle)
+	}
+	if s.indeterminate {
+		return s.buildBusyHorizontalTrack()
 	}
 
 	pct := s.state.Percentage()
@@ -726,12 +1194,14 @@ func (s *Slider) buildHorizontalTrack() string {
 
 	emptyCells := availableWidth - filledCells
 
+	filledStyle, emptyStyle := s.currentFilledStyle(), s.currentEmptyStyle()
+
 	var track strings.Builder
 
 	// Build filled portion
 	filledSymbolWidth := runewidth.StringWidth(s.symbols.Filled)
 	for i := 0; i < filledCells; {
-		track.WriteString(s.filledStyle.Render(s.symbols.Filled))
+		track.WriteString(s.filledStyleAt(filledStyle, i, filledCells).Render(s.symbols.Filled))
 		i += filledSymbolWidth
 		if i > filledCells {
 			break
@@ -740,13 +1210,13 @@ func (s *Slider) buildHorizontalTrack() string {
 
 	// Render handle
 	if s.showHandle {
-		track.WriteString(s.handleStyle.Render(s.symbols.Handle))
+		track.WriteString(s.renderHandle(markHandle))
 	}
 
 	// Build empty portion
 	emptySymbolWidth := runewidth.StringWidth(s.symbols.Empty)
 	for i := 0; i < emptyCells; {
-		track.WriteString(s.emptyStyle.Render(s.symbols.Empty))
+		track.WriteString(emptyStyle.Render(s.symbols.Empty))
 		i += emptySymbolWidth
 		if i > emptyCells {
 			break
@@ -756,11 +1226,35 @@ func (s *Slider) buildHorizontalTrack() string {
 	return track.String()
 }
 
-// buildSegmentedHorizontalTrack builds a segmented horizontal slider track.
-func (s *Slider) buildSegmentedHorizontalTrack() string {
-	pct := s.state.Percentage()
+// buildBusyHorizontalTrack builds the horizontal track in indeterminate mode.
+func (s *Slider) buildBusyHorizontalTrack() string {
+	from, to := s.busyCells(s.width)
+	filledStyle, emptyStyle := s.currentFilledStyle(), s.currentEmptyStyle()
+
+	var track strings.Builder
+	for i := 0; i < s.width; {
+		symbol, style := s.symbols.Empty, emptyStyle
+		if i >= from && i < to {
+			symbol, style = s.symbols.Filled, filledStyle
+		}
+		track.WriteString(style.Render(symbol))
+		i += max(runewidth.StringWidth(symbol), 1)
+	}
+
+	return track.String()
+}
+
+// renderHandle renders the styled handle, optionally wrapped in zone markers.
+func (s *Slider) renderHandle(mark bool) string {
+	handle := s.currentHandleStyle().Render(s.symbols.Handle)
+	if mark {
+		handle = markZone(handleZone, handle)
+	}
+	return handle
+}
 
-	// Determine segment count
+// segments returns the number of segments to draw in segmented mode.
+func (s *Slider) segments() int {
 	segmentCount := s.segmentCount
 	if segmentCount <= 0 {
 		// Auto-calculate: approximately one segment per 2-3 characters
@@ -772,6 +1266,13 @@ func (s *Slider) buildSegmentedHorizontalTrack() string {
 			segmentCount = 20
 		}
 	}
+	return segmentCount
+}
+
+// buildSegmentedHorizontalTrack builds a segmented horizontal slider track.
+func (s *Slider) buildSegmentedHorizontalTrack(markHandle bool) string {
+	pct := s.state.Percentage()
+	segmentCount := s.segments()
 
 	// Calculate how many segments should be filled
 	filledSegments := int(float64(segmentCount) * pct)
@@ -785,20 +1286,29 @@ func (s *Slider) buildSegmentedHorizontalTrack() string {
 		handlePos = segmentCount - 1
 	}
 
+	filledStyle, emptyStyle := s.currentFilledStyle(), s.currentEmptyStyle()
+
 	var track strings.Builder
 	gap := strings.Repeat(" ", s.segmentGap)
+	busyFrom, busyTo := s.busyCells(segmentCount)
 
 	for i := 0; i < segmentCount; i++ {
 		if i > 0 {
 			track.WriteString(gap)
 		}
 
-		if s.showHandle && i == handlePos {
-			track.WriteString(s.handleStyle.Render(s.symbols.Handle))
+		if s.indeterminate {
+			if i >= busyFrom && i < busyTo {
+				track.WriteString(filledStyle.Render(s.symbols.Filled))
+			} else {
+				track.WriteString(emptyStyle.Render(s.symbols.Empty))
+			}
+		} else if s.showHandle && i == handlePos {
+			track.WriteString(s.renderHandle(markHandle))
 		} else if i < filledSegments {
-			track.WriteString(s.filledStyle.Render(s.symbols.Filled))
+			track.WriteString(s.filledStyleAt(filledStyle, i, filledSegments).Render(s.symbols.Filled))
 		} else {
-			track.WriteString(s.emptyStyle.Render(s.symbols.Empty))
+			track.WriteString(emptyStyle.Render(s.symbols.Empty))
 		}
 	}
 
@@ -806,16 +1316,21 @@ func (s *Slider) buildSegmentedHorizontalTrack() string {
 }
 
 // renderVertical renders a vertical slider.
-func (s *Slider) renderVertical() string {
-	trackLines := s.buildVerticalTrack()
+func (s *Slider) renderVertical(markZones bool) string {
+	trackLines := s.buildVerticalTrack(markZones)
+	if markZones {
+		for i, line := range trackLines {
+			trackLines[i] = markZone(trackZone, line)
+		}
+	}
 	label := ""
 	value := ""
 
 	if s.label != "" {
-		label = s.labelStyle.Render(s.label)
+		label = s.textStyle(s.labelStyle).Render(s.label)
 	}
 	if s.showValue {
-		value = s.valueStyle.Render(s.formatValue())
+		value = s.textStyle(s.valueStyle).Render(s.formatValue())
 	}
 
 	// Resolve collisions if enabled
@@ -831,11 +1346,8 @@ func (s *Slider) renderVertical() string {
 	}
 
 	var result strings.Builder
-	labelWidth := runewidth.StringWidth(label)
-	valueWidth := runewidth.StringWidth(value)
-
-	// Unused but kept for potential future alignment features
-	_ = runewidth.StringWidth(label)
+	labelWidth := lipgloss.Width(label)
+	valueWidth := lipgloss.Width(value)
 
 	// Build top section
 	if labelPos == LabelTop && label != "" {
@@ -895,12 +1407,16 @@ func (s *Slider) renderVertical() string {
 		result.WriteString("\n")
 		result.WriteString(value)
 	}
+	if tip := s.tooltip(); tip != "" {
+		result.WriteString("\n")
+		result.WriteString(tip)
+	}
 
 	return result.String()
 }
 
 // buildVerticalTrack builds the vertical slider track lines.
-func (s *Slider) buildVerticalTrack() []string {
+func (s *Slider) buildVerticalTrack(markHandle bool) []string {
 	pct := s.state.Percentage()
 	trackHeight := s.height
 
@@ -923,36 +1439,226 @@ func (s *Slider) buildVerticalTrack() []string {
 		}
 	}
 
+	filledStyle, emptyStyle := s.currentFilledStyle(), s.currentEmptyStyle()
+
 	var lines []string
+	busyFrom, busyTo := s.busyCells(trackHeight)
 
 	// Build from top to bottom
 	for i := 0; i < trackHeight; i++ {
-		if s.showHandle && i == handleRow {
-			lines = append(lines, s.handleStyle.Render(s.symbols.Handle))
+		if s.indeterminate {
+			// The busy span runs from the bottom like the fill
+			if row := trackHeight - 1 - i; row >= busyFrom && row < busyTo {
+				lines = append(lines, filledStyle.Render(s.symbols.Filled))
+			} else {
+				lines = append(lines, emptyStyle.Render(s.symbols.Empty))
+			}
+		} else if s.showHandle && i == handleRow {
+			lines = append(lines, s.renderHandle(markHandle))
 		} else if i < emptyRows {
-			lines = append(lines, s.emptyStyle.Render(s.symbols.Empty))
+			lines = append(lines, emptyStyle.Render(s.symbols.Empty))
 		} else {
-			lines = append(lines, s.filledStyle.Render(s.symbols.Filled))
+			// Filled rows are counted from the bottom
+			style := s.filledStyleAt(filledStyle, trackHeight-1-i, filledRows)
+			lines = append(lines, style.Render(s.symbols.Filled))
 		}
 	}
 
 	return lines
 }
 
-// formatValue formats the current value for display.
+// PercentageAt returns the percentage (0.0 to 1.0) that places the handle
+// on the given cell of the track. This is the inverse of the slider's own
+// layout: it accounts for the handle width, wide glyphs and the gaps
+// between segments. Coordinates are relative to TrackRect and out-of-range
+// cells map to the nearest end.
+// Without a handle, the track is treated as continuous from the first
+// cell (0%) to the last (100%).
+func (s *Slider) PercentageAt(col, row int) float64 {
+	if s.orientation == Vertical {
+		h := s.height
+		if !s.hasHandle() {
+			return continuousPercentage(h-1-row, h)
+		}
+		// Rows count down from the top; the handle sits on the first
+		// filled row, or the bottom row when nothing is filled.
+		k := nearestStep(row, h, func(k int) int {
+			return min(h-k, h-1)
+		})
+		return float64(k) / float64(h)
+	}
+
+	if s.segmented {
+		n := s.segments()
+		if !s.hasHandle() {
+			// Clicking segment i fills everything before it; the last
+			// segment fills the whole track.
+			return continuousPercentage(s.segmentAt(col), n)
+		}
+		k := nearestStep(col, n, func(k int) int {
+			start, width := s.segmentSpan(min(k, n-1), k)
+			return start + width/2
+		})
+		return float64(k) / float64(n)
+	}
+
+	if !s.hasHandle() {
+		return continuousPercentage(col, lipgloss.Width(s.buildHorizontalTrack(false)))
+	}
+
+	handleWidth := runewidth.StringWidth(s.symbols.Handle)
+	filledWidth := max(runewidth.StringWidth(s.symbols.Filled), 1)
+	available := max(s.width-handleWidth, 0)
+
+	// The filled portion is drawn in whole glyphs, so the handle starts
+	// at the filled width rounded up to a glyph boundary.
+	k := nearestStep(col, available, func(k int) int {
+		glyphs := (k + filledWidth - 1) / filledWidth
+		return glyphs*filledWidth + handleWidth/2
+	})
+	if available == 0 {
+		return 0
+	}
+	return float64(k) / float64(available)
+}
+
+// ValueAt returns the value that places the handle on the given cell of
+// the track. See PercentageAt.
+func (s *Slider) ValueAt(col, row int) float64 {
+	return s.state.Min() + s.PercentageAt(col, row)*s.state.Range()
+}
+
+// trackSteps returns the number of steps PercentageAt divides the track
+// into; it returns k/trackSteps for some k in [0, trackSteps].
+func (s *Slider) trackSteps() int {
+	var steps int
+	switch {
+	case s.orientation == Vertical:
+		steps = s.height
+	case s.segmented:
+		steps = s.segments()
+	case s.hasHandle():
+		steps = s.width - runewidth.StringWidth(s.symbols.Handle)
+	default:
+		steps = lipgloss.Width(s.buildHorizontalTrack(false))
+	}
+	if !s.hasHandle() {
+		steps--
+	}
+	return max(steps, 0)
+}
+
+// snapToTrack returns the value PercentageAt can return that is nearest to
+// value. Positions are doubled so halfway values go toward the ends, as
+// clicks do.
+func (s *Slider) snapToTrack(value float64) float64 {
+	n := s.trackSteps()
+	if n == 0 || s.state.Range() == 0 {
+		return s.state.Min()
+	}
+	pct := Clamp((value-s.state.Min())/s.state.Range(), 0, 1)
+	k := nearestStep(int(math.Round(2*pct*float64(n))), n, func(k int) int {
+		return 2 * k
+	})
+	return s.state.Min() + float64(k)/float64(n)*s.state.Range()
+}
+
+// hasHandle returns true if a visible handle is drawn.
+func (s *Slider) hasHandle() bool {
+	return s.showHandle && !s.indeterminate && runewidth.StringWidth(s.symbols.Handle) > 0
+}
+
+// segmentSpan returns the start column and width of segment i when
+// filled segments are filled and the handle (if any) sits on segment
+// min(filled, count-1).
+func (s *Slider) segmentSpan(i, filled int) (start, width int) {
+	handlePos := min(filled, s.segments()-1)
+	for j := 0; j <= i; j++ {
+		switch {
+		case s.showHandle && j == handlePos:
+			width = runewidth.StringWidth(s.symbols.Handle)
+		case j < filled:
+			width = runewidth.StringWidth(s.symbols.Filled)
+		default:
+			width = runewidth.StringWidth(s.symbols.Empty)
+		}
+		if j < i {
+			start += width + s.segmentGap
+		}
+	}
+	return start, width
+}
+
+// segmentAt returns the index of the segment closest to col, using the
+// layout of the current value.
+func (s *Slider) segmentAt(col int) int {
+	n := s.segments()
+	filled := int(float64(n) * s.state.Percentage())
+	return nearestStep(col, n-1, func(i int) int {
+		start, width := s.segmentSpan(i, filled)
+		return start + max(width-1, 0)/2
+	})
+}
+
+// nearestStep returns the step in [0, steps] whose anchor position is
+// closest to pos. Ties go to the step closer to either end, so the
+// extremes stay reachable when several steps share a position.
+func nearestStep(pos, steps int, anchor func(int) int) int {
+	best, bestDist := 0, -1
+	for k := 0; k <= steps; k++ {
+		dist := pos - anchor(k)
+		if dist < 0 {
+			dist = -dist
+		}
+		if bestDist < 0 || dist < bestDist ||
+			(dist == bestDist && absInt(2*k-steps) > absInt(2*best-steps)) {
+			best, bestDist = k, dist
+		}
+	}
+	return best
+}
+
+// continuousPercentage maps a cell on a track of the given length to a
+// percentage, with the first cell at 0 and the last at 1.
+func continuousPercentage(pos, length int) float64 {
+	if length <= 1 {
+		return 0
+	}
+	return Clamp(float64(pos)/float64(length-1), 0, 1)
+}
+
+// absInt returns the absolute value of an integer.
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// formatValue formats the current value for display. There is no value
+// to show in indeterminate mode.
 func (s *Slider) formatValue() string {
-	v := s.state.Value()
+	if s.indeterminate {
+		return "--"
+	}
+	return s.formatNumber(s.state.Value())
+}
 
+// formatNumber formats a value using the slider's value format.
+func (s *Slider) formatNumber(v float64) string {
 	// If custom format is specified, use it
 	if s.valueFormat != "" {
 		return fmt.Sprintf(s.valueFormat, v)
 	}
 
-	// Check if value is effectively an integer (no fractional part)
+	return fmt.Sprintf("%.*f", defaultDecimals(v), v)
+}
+
+// defaultDecimals returns the decimal places shown without a value format:
+// none for whole numbers, one otherwise.
+func defaultDecimals(v float64) int {
 	if v == math.Trunc(v) {
-		return fmt.Sprintf("%.0f", v)
+		return 0
 	}
-
-	// For decimal values, show one decimal place
-	return fmt.Sprintf("%.1f", v)
+	return 1
 }
@@ -411,6 +411,284 @@ func TestView_CustomFormat(t *testing.T) {
 	}
 }
 
+func TestTrackRect(t *testing.T) {
+	state := NewState(WithValue(50))
+
+	tests := []struct {
+		name     string
+		slider   *Slider
+		expected Rect
+	}{
+		{
+			"plain",
+			New(state, WithWidth(10)),
+			Rect{X: 0, Y: 0, Width: 10, Height: 1},
+		},
+		{
+			"label left and value",
+			New(state, WithWidth(10), WithLabel("Vol"), WithLabelPosition(LabelLeft), WithShowValue(true)),
+			Rect{X: 4, Y: 0, Width: 10, Height: 1},
+		},
+		{
+			"label top with border and title",
+			New(state, WithWidth(10), WithLabel("Vol"), WithLabelPosition(LabelTop),
+				WithBorder(BorderRounded), WithBorderTitle("Title")),
+			Rect{X: 1, Y: 3, Width: 10, Height: 1},
+		},
+		{
+			"vertical with label and value",
+			New(state, WithHeight(5), WithOrientation(Vertical), WithLabel("Vol"),
+				WithLabelPosition(LabelLeft), WithShowValue(true), WithValuePosition(ValueTop)),
+			Rect{X: 4, Y: 1, Width: 1, Height: 5},
+		},
+		{
+			"segmented",
+			New(state, WithWidth(10), WithSegmented(true), WithSegmentCount(5)),
+			Rect{X: 0, Y: 0, Width: 9, Height: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.slider.TrackRect(); got != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHandleRect(t *testing.T) {
+	state := NewState(WithValue(50))
+
+	slider := New(state, WithWidth(11), WithLabel("Vol"), WithLabelPosition(LabelLeft))
+	if got, expected := slider.HandleRect(), (Rect{X: 9, Y: 0, Width: 1, Height: 1}); got != expected {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	vertical := New(state, WithHeight(10), WithOrientation(Vertical))
+	if got, expected := vertical.HandleRect(), (Rect{X: 0, Y: 5, Width: 1, Height: 1}); got != expected {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	progress := New(state, WithWidth(10), WithHandle(false))
+	if got := progress.HandleRect(); got.Width != 0 {
+		t.Errorf("Expected empty handle rect in progress mode, got %+v", got)
+	}
+}
+
+func TestTrackRect_DoesNotChangeView(t *testing.T) {
+	slider := New(NewState(WithValue(30)), WithWidth(10), WithBorder(BorderRounded))
+	before := slider.View()
+	slider.TrackRect()
+	if slider.View() != before {
+		t.Error("TrackRect should not affect rendering")
+	}
+}
+
+func TestTrackRect_CachedFromView(t *testing.T) {
+	state := NewState(WithValue(9), WithMax(100))
+	slider := New(state, WithWidth(10), WithShowValue(true), WithValuePosition(ValueLeft))
+
+	slider.View()
+	cached := slider.laidOut
+	if slider.TrackRect(); slider.laidOut != cached {
+		t.Error("TrackRect should reuse the layout of the last view")
+	}
+
+	// A wider value moves the track
+	state.SetValue(10)
+	if got := slider.TrackRect().X; got != 3 {
+		t.Errorf("Expected the track to move to column 3, got %d", got)
+	}
+
+	slider.SetIndeterminate(true)
+	if got := slider.HandleRect(); got.Width != 0 {
+		t.Errorf("Expected no handle once indeterminate, got %+v", got)
+	}
+}
+
+func TestHoverStyles(t *testing.T) {
+	slider := New(NewState(),
+		WithHandleStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("255"))),
+		WithHoverHandleStyle(lipgloss.NewStyle().Bold(true)),
+	)
+
+	if slider.currentHandleStyle().GetBold() {
+		t.Error("Hover style should not apply before hovering")
+	}
+
+	slider.SetHover(40)
+	style := slider.currentHandleStyle()
+	if !style.GetBold() {
+		t.Error("Hover handle style should apply while hovered")
+	}
+	if style.GetForeground() != lipgloss.Color("255") {
+		t.Error("Unset hover properties should fall back to the regular style")
+	}
+
+	slider.ClearHover()
+	if slider.IsHovered() {
+		t.Error("ClearHover should remove hover state")
+	}
+}
+
+func TestHoverTooltip(t *testing.T) {
+	state := NewState(WithValue(10))
+	slider := New(state, WithWidth(10), WithHoverTooltip(true))
+
+	if strings.Contains(slider.View(), "(") {
+		t.Error("Tooltip should not render without hover")
+	}
+
+	before, idle := slider.TrackRect(), lipgloss.Width(slider.View())
+	slider.SetHover(42)
+	if !strings.Contains(slider.View(), "(44.4)") {
+		t.Errorf("Expected tooltip with the snapped hover value, got %q", slider.View())
+	}
+	if slider.TrackRect() != before {
+		t.Error("Tooltip should not move the track")
+	}
+	for _, v := range []float64{0, 42, 100} {
+		slider.SetHover(v)
+		if w := lipgloss.Width(slider.View()); w != idle {
+			t.Errorf("Hovering %v changed the width from %d to %d", v, idle, w)
+		}
+	}
+
+	vertical := New(state, WithHeight(5), WithOrientation(Vertical), WithHoverTooltip(true))
+	idleHeight := lipgloss.Height(vertical.View())
+	vertical.SetHover(42)
+	if !strings.Contains(vertical.View(), "(40)") {
+		t.Errorf("Expected tooltip on vertical slider, got %q", vertical.View())
+	}
+	if lipgloss.Height(vertical.View()) != idleHeight {
+		t.Error("Tooltip should not change the vertical slider's height")
+	}
+
+	// The width is measured once for the bounds
+	cached := slider.tipWidth
+	if slider.View(); cached == nil || slider.tipWidth != cached {
+		t.Error("Expected the tooltip width to be reused")
+	}
+	state.SetMax(1000)
+	if slider.tooltipWidth() != len("(111.1)") {
+		t.Errorf("Expected the width to follow the bounds, got %d", slider.tooltipWidth())
+	}
+}
+
+func TestSlider_SetHoverSnapsToTrack(t *testing.T) {
+	slider := New(NewState(WithMax(100)), WithWidth(10))
+
+	// Every hovered value is one a click could set
+	for _, v := range []float64{0, 5, 42, 50, 99.9, 100, 120} {
+		slider.SetHover(v)
+		col := -1
+		for c := 0; c < 10; c++ {
+			if slider.PercentageAt(c, 0)*100 == slider.HoverValue() {
+				col = c
+			}
+		}
+		if col < 0 {
+			t.Errorf("SetHover(%v) gave %v, which no click sets", v, slider.HoverValue())
+		}
+	}
+}
+
+// assertHandleFollowsClicks checks that every track cell a handle can be
+// drawn on maps back to a value that draws the handle on that cell.
+func assertHandleFollowsClicks(t *testing.T, slider *Slider) {
+	t.Helper()
+
+	track := slider.TrackRect()
+	state := slider.State()
+
+	for pos := 0; pos < max(track.Width, track.Height); pos++ {
+		col, row := pos, 0
+		if slider.orientation == Vertical {
+			col, row = 0, pos
+		}
+
+		state.SetFromPercentage(slider.PercentageAt(col, row))
+		handle := slider.HandleRect()
+		hx, hy := handle.X-track.X, handle.Y-track.Y
+
+		if slider.orientation == Vertical {
+			if hy != row {
+				t.Errorf("Click on row %d drew handle on row %d", row, hy)
+			}
+			continue
+		}
+
+		// Clicks in segment gaps may land on either neighbor
+		dist := 0
+		if col < hx {
+			dist = hx - col
+		} else if col >= hx+handle.Width {
+			dist = col - (hx + handle.Width - 1)
+		}
+		if dist > slider.segmentGap {
+			t.Errorf("Click on column %d drew handle at columns %d-%d", col, hx, hx+handle.Width-1)
+		}
+	}
+}
+
+func TestPercentageAt_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		slider *Slider
+	}{
+		{"horizontal", New(NewState(), WithWidth(21))},
+		{"bordered with label", New(NewState(), WithWidth(15), WithLabel("Vol"),
+			WithLabelPosition(LabelLeft), WithBorder(BorderDouble))},
+		{"segmented", New(NewState(), WithWidth(20), WithSegmented(true), WithSegmentCount(7), WithSegmentGap(2))},
+		{"segmented no gap", New(NewState(), WithSegmented(true), WithSegmentCount(5), WithSegmentGap(0))},
+		{"wide handle", New(NewState(), WithWidth(20), WithSymbols(Symbols{Filled: "=", Empty: "-", Handle: "＠"}))},
+		{"vertical", New(NewState(), WithHeight(8), WithOrientation(Vertical))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertHandleFollowsClicks(t, tt.slider)
+		})
+	}
+}
+
+func TestPercentageAt_Extremes(t *testing.T) {
+	tests := []struct {
+		name        string
+		slider      *Slider
+		first, last [2]int
+	}{
+		{"horizontal", New(NewState(), WithWidth(10)), [2]int{0, 0}, [2]int{9, 0}},
+		{"segmented", New(NewState(), WithWidth(10), WithSegmented(true), WithSegmentCount(5)), [2]int{0, 0}, [2]int{8, 0}},
+		{"progress", New(NewState(), WithWidth(10), WithHandle(false)), [2]int{0, 0}, [2]int{9, 0}},
+		{"segmented progress", New(NewState(), WithSegmented(true), WithSegmentCount(5), WithHandle(false)), [2]int{0, 0}, [2]int{8, 0}},
+		{"vertical", New(NewState(), WithHeight(10), WithOrientation(Vertical)), [2]int{0, 9}, [2]int{0, 0}},
+		{"wide filled", New(NewState(), WithWidth(11), WithSymbols(Symbols{Filled: "＝", Empty: "-", Handle: "O"})), [2]int{0, 0}, [2]int{10, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if pct := tt.slider.PercentageAt(tt.first[0], tt.first[1]); pct != 0 {
+				t.Errorf("Expected first cell to map to 0, got %f", pct)
+			}
+			if pct := tt.slider.PercentageAt(tt.last[0], tt.last[1]); pct != 1 {
+				t.Errorf("Expected last cell to map to 1, got %f", pct)
+			}
+			if pct := tt.slider.PercentageAt(-5, -5); pct < 0 || pct > 1 {
+				t.Errorf("Out-of-range cell should clamp, got %f", pct)
+			}
+		})
+	}
+}
+
+func TestValueAt(t *testing.T) {
+	slider := New(NewState(WithMin(100), WithMax(200)), WithWidth(11))
+	if v := slider.ValueAt(5, 0); v != 150 {
+		t.Errorf("Expected 150, got %f", v)
+	}
+}
+
 func TestString(t *testing.T) {
 	state := NewState(WithValue(50))
 	slider := New(state, WithWidth(10))
@@ -526,3 +804,39 @@ func TestMultipleOptions(t *testing.T) {
 		t.Error("Expected border style BorderRounded")
 	}
 }
+
+func TestSlider_DisabledAndReadOnly(t *testing.T) {
+	slider := New(NewState(WithValue(50)), WithWidth(10), WithHoverTooltip(true))
+	if !slider.Editable() || slider.currentFilledStyle().GetFaint() {
+		t.Error("Sliders should start editable and undimmed")
+	}
+
+	slider.SetDisabled(true)
+	slider.SetHover(80)
+	if !slider.IsDisabled() || slider.Editable() {
+		t.Error("Disabled slider should not be editable")
+	}
+	for _, style := range []lipgloss.Style{slider.currentFilledStyle(),
+		slider.currentEmptyStyle(), slider.currentHandleStyle(), slider.textStyle(slider.labelStyle)} {
+		if !style.GetFaint() {
+			t.Error("Disabled slider should be dimmed")
+		}
+	}
+	if slider.tooltip() != "" {
+		t.Error("Disabled slider should not preview values")
+	}
+
+	slider = New(NewState(), WithReadOnly(true),
+		WithDisabledStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("240"))))
+	if !slider.IsReadOnly() || slider.Editable() {
+		t.Error("Read-only slider should not be editable")
+	}
+	if slider.currentFilledStyle().GetFaint() {
+		t.Error("Read-only slider should look as usual")
+	}
+
+	slider.SetDisabled(true)
+	if fg := slider.currentHandleStyle().GetForeground(); fg != lipgloss.Color("240") {
+		t.Errorf("Expected the custom disabled style, got %v", fg)
+	}
+}
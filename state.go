@@ -18,10 +18,11 @@ package tuslide
 // SliderState manages the value and bounds of a slider.
 // It handles clamping, stepping, and percentage calculations.
 type SliderState struct {
-	min   float64
-	max   float64
-	value float64
-	step  float64
+	min    float64
+	max    float64
+	value  float64
+	step   float64
+	motion MotionPreference // For animators of this state
 }
 
 // StateOption is a functional option for configuring SliderState.
@@ -124,6 +125,20 @@ func (s *SliderState) SetStep(step float64) {
 	}
 }
 
+// SetMotionPreference sets the motion preference of animations of this
+// state, overriding their manager's and the global one, e.g. to reduce
+// motion for one slider only. Animators with a preference of their own
+// keep it. MotionDefault removes the override.
+func (s *SliderState) SetMotionPreference(p MotionPreference) {
+	s.motion = p
+}
+
+// MotionPreference returns the state's own motion preference, or
+// MotionDefault if it has none.
+func (s *SliderState) MotionPreference() MotionPreference {
+	return s.motion
+}
+
 // Increment increases the value by one step, respecting the maximum bound.
 func (s *SliderState) Increment() {
 	s.SetValue(s.value + s.step)
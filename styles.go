@@ -13,11 +13,18 @@ type SliderStyle struct {
 	LabelStyle   lipgloss.Style
 	ValueStyle   lipgloss.Style
 	Segmented    bool // Whether to render as discrete segments
+
+	// Optional settings; zero values keep the slider's own
+	SegmentCount int            // Number of segments
+	SegmentGap   int            // Gap between segments
+	Border       BorderStyle    // Border around the slider
+	BorderColor  lipgloss.Color // Border color
+	Gradient     Gradient       // Colors blended across the filled track
 }
 
 // Apply applies this style to a slider via functional options.
 func (s SliderStyle) Apply() []SliderOption {
-	return []SliderOption{
+	opts := []SliderOption{
 		WithSymbols(s.Symbols),
 		WithFilledStyle(s.FilledStyle),
 		WithEmptyStyle(s.EmptyStyle),
@@ -25,6 +32,7 @@ func (s SliderStyle) Apply() []SliderOption {
 		WithLabelStyle(s.LabelStyle),
 		WithValueStyle(s.ValueStyle),
 	}
+	return append(opts, func(sl *Slider) { sl.applyStyleExtras(s) })
 }
 
 // ============================================================================
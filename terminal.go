@@ -0,0 +1,205 @@
+package tuslide
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// TerminalCapabilities describes what the terminal can display. Sliders
+// degrade to fit when they are created: without Unicode they switch to
+// ASCIISymbols, and when the color profile can't tell the filled track,
+// empty track and handle apart, they get distinct glyphs instead.
+type TerminalCapabilities struct {
+	// Profile is the color profile; termenv.Ascii means no color.
+	Profile termenv.Profile
+	// Unicode is false when the locale's encoding isn't UTF-8.
+	Unicode bool
+}
+
+// FullTerminal is a terminal with true color and Unicode, under which
+// sliders render exactly as configured.
+var FullTerminal = TerminalCapabilities{Profile: termenv.TrueColor, Unicode: true}
+
+// HasColor reports whether the terminal shows colors.
+func (c TerminalCapabilities) HasColor() bool {
+	return c.Profile != termenv.Ascii
+}
+
+// globalTerminal holds the global TerminalCapabilities.
+var globalTerminal atomic.Pointer[TerminalCapabilities]
+
+func init() {
+	SetTerminalCapabilities(TerminalFromEnv())
+}
+
+// TerminalFromEnv detects capabilities from the environment alone, so the
+// result doesn't depend on whether output is redirected:
+//
+//   - NO_COLOR (any value) or TERM=dumb: no color
+//   - COLORTERM=truecolor or 24bit: true color
+//   - TERM naming 256 colors, or unset: 256 colors
+//   - other TERM values: 16 colors
+//   - LC_ALL, LC_CTYPE or LANG (the first one set) without UTF-8: ASCII
+func TerminalFromEnv() TerminalCapabilities {
+	return TerminalCapabilities{
+		Profile: colorProfileFromEnv(),
+		Unicode: unicodeFromEnv(),
+	}
+}
+
+// colorProfileFromEnv picks the color profile from NO_COLOR, TERM and
+// COLORTERM.
+func colorProfileFromEnv() termenv.Profile {
+	term := strings.ToLower(os.Getenv("TERM"))
+	colorTerm := strings.ToLower(os.Getenv("COLORTERM"))
+
+	switch {
+	case os.Getenv("NO_COLOR") != "" || term == "dumb":
+		return termenv.Ascii
+	case colorTerm == "truecolor" || colorTerm == "24bit":
+		return termenv.TrueColor
+	case term == "" || strings.Contains(term, "256color"):
+		return termenv.ANSI256
+	}
+	return termenv.ANSI
+}
+
+// unicodeFromEnv reports whether the locale uses UTF-8. An unset locale
+// is assumed to, as most terminals do.
+func unicodeFromEnv() bool {
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := strings.ToLower(os.Getenv(key)); v != "" {
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+	return true
+}
+
+// SetTerminalCapabilities sets the capabilities new sliders adapt to,
+// e.g. FullTerminal to turn the fallbacks off.
+func SetTerminalCapabilities(c TerminalCapabilities) {
+	globalTerminal.Store(&c)
+}
+
+// GlobalTerminalCapabilities returns the capabilities new sliders adapt to.
+func GlobalTerminalCapabilities() TerminalCapabilities {
+	return *globalTerminal.Load()
+}
+
+// WithTerminalCapabilities sets the capabilities the slider adapts to,
+// instead of the global ones.
+func WithTerminalCapabilities(c TerminalCapabilities) SliderOption {
+	return func(s *Slider) {
+		s.terminal = c
+	}
+}
+
+// Glyphs substituted when parts of the track would look the same.
+var (
+	fallbackEmpty       = []string{"░", "·", "-", "."}
+	fallbackHandle      = []string{"●", "◆", "O", "#"}
+	fallbackEmptyASCII  = []string{"-", ".", "_"}
+	fallbackHandleASCII = []string{"O", "#", "|"}
+)
+
+// adaptToTerminal degrades the slider's symbols and styles to what its
+// terminal can display. It runs once, after the options are applied.
+func (s *Slider) adaptToTerminal() {
+	caps := s.terminal
+
+	if !caps.Unicode && !symbolsAreASCII(s.symbols) {
+		s.symbols = ASCIISymbols()
+	}
+
+	emptyGlyphs, handleGlyphs := fallbackEmpty, fallbackHandle
+	if !caps.Unicode {
+		emptyGlyphs, handleGlyphs = fallbackEmptyASCII, fallbackHandleASCII
+	}
+
+	// Parts that only differ by color need another cue
+	filled, empty, handle := s.filledStyle.GetForeground(), s.emptyStyle.GetForeground(),
+		s.handleStyle.GetForeground()
+	if s.symbols.Filled == s.symbols.Empty && collapses(caps.Profile, filled, empty) {
+		s.symbols.Empty = pickGlyph(emptyGlyphs, s.symbols.Filled, s.symbols.Handle)
+	}
+	if (s.symbols.Handle == s.symbols.Filled && collapses(caps.Profile, handle, filled)) ||
+		(s.symbols.Handle == s.symbols.Empty && collapses(caps.Profile, handle, empty)) {
+		s.symbols.Handle = pickGlyph(handleGlyphs, s.symbols.Filled, s.symbols.Empty)
+	}
+
+	// Without color, use attributes for renderers that still show them
+	if !caps.HasColor() {
+		strip := func(style lipgloss.Style) lipgloss.Style {
+			return style.UnsetForeground().UnsetBackground()
+		}
+		s.filledStyle = strip(s.filledStyle).Bold(true)
+		s.emptyStyle = strip(s.emptyStyle)
+		s.handleStyle = strip(s.handleStyle).Reverse(true)
+		s.labelStyle = strip(s.labelStyle)
+		s.valueStyle = strip(s.valueStyle)
+		s.hoverFilledStyle = strip(s.hoverFilledStyle)
+		s.hoverEmptyStyle = strip(s.hoverEmptyStyle)
+		s.hoverHandleStyle = strip(s.hoverHandleStyle)
+		s.tooltipStyle = strip(s.tooltipStyle)
+		s.disabledStyle = strip(s.disabledStyle)
+		s.gradient = Gradient{}
+	}
+}
+
+// collapses reports whether two different colors look the same under the
+// profile. Identical colors don't count: the style never relied on them.
+func collapses(profile termenv.Profile, a, b lipgloss.TerminalColor) bool {
+	ca, okA := rgbOf(a)
+	cb, okB := rgbOf(b)
+	if !okA || !okB || ca == cb {
+		return false
+	}
+	return profileColor(profile, a) == profileColor(profile, b)
+}
+
+// profileColor converts a color to the closest one the profile has. ANSI
+// indices are converted directly, so they keep their terminal-defined
+// palette entry.
+func profileColor(profile termenv.Profile, c lipgloss.TerminalColor) termenv.Color {
+	switch v := c.(type) {
+	case lipgloss.Color:
+		return profile.Color(string(v))
+	case lipgloss.ANSIColor:
+		return profile.Color(strconv.Itoa(int(v)))
+	}
+	rgb, _ := rgbOf(c)
+	return profile.Color(rgb.Hex())
+}
+
+// pickGlyph returns the first candidate that differs from the glyphs in
+// use.
+func pickGlyph(candidates []string, used ...string) string {
+	for _, c := range candidates {
+		taken := false
+		for _, u := range used {
+			taken = taken || c == u
+		}
+		if !taken {
+			return c
+		}
+	}
+	return candidates[len(candidates)-1]
+}
+
+// symbolsAreASCII reports whether all symbols are plain ASCII.
+func symbolsAreASCII(s Symbols) bool {
+	for _, sym := range []string{s.Filled, s.Empty, s.Handle} {
+		for i := 0; i < len(sym); i++ {
+			if sym[i] >= utf8.RuneSelf {
+				return false
+			}
+		}
+	}
+	return true
+}
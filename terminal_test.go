@@ -0,0 +1,139 @@
+package tuslide
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// TestMain renders and animates sliders as configured, whatever terminal
+// and motion preference run the tests.
+func TestMain(m *testing.M) {
+	SetTerminalCapabilities(FullTerminal)
+	SetMotionPreference(MotionFull)
+	os.Exit(m.Run())
+}
+
+func TestTerminalFromEnv(t *testing.T) {
+	tests := []struct {
+		env     map[string]string
+		profile termenv.Profile
+		unicode bool
+	}{
+		{map[string]string{"TERM": "xterm-256color"}, termenv.ANSI256, true},
+		{map[string]string{"TERM": "xterm-256color", "NO_COLOR": "1"}, termenv.Ascii, true},
+		{map[string]string{"TERM": "dumb"}, termenv.Ascii, true},
+		{map[string]string{"TERM": "xterm", "COLORTERM": "truecolor"}, termenv.TrueColor, true},
+		{map[string]string{"TERM": "linux"}, termenv.ANSI, true},
+		{map[string]string{"TERM": "", "LANG": "en_US.UTF-8"}, termenv.ANSI256, true},
+		{map[string]string{"LANG": "C"}, termenv.ANSI256, false},
+		{map[string]string{"LC_ALL": "de_DE.utf8", "LANG": "C"}, termenv.ANSI256, true},
+		{map[string]string{"LC_CTYPE": "en_US.ISO-8859-1"}, termenv.ANSI256, false},
+	}
+
+	for _, tt := range tests {
+		for _, key := range []string{"TERM", "COLORTERM", "NO_COLOR", "LC_ALL", "LC_CTYPE", "LANG"} {
+			t.Setenv(key, tt.env[key])
+		}
+
+		got := TerminalFromEnv()
+		if got.Profile != tt.profile || got.Unicode != tt.unicode {
+			t.Errorf("%v: expected profile %v and unicode %v, got %+v", tt.env, tt.profile, tt.unicode, got)
+		}
+	}
+}
+
+func TestSetTerminalCapabilities(t *testing.T) {
+	previous := GlobalTerminalCapabilities()
+	t.Cleanup(func() { SetTerminalCapabilities(previous) })
+
+	SetTerminalCapabilities(TerminalCapabilities{Profile: termenv.ANSI256, Unicode: false})
+	if view := New(NewState(WithValue(50)), WithWidth(10)).View(); view != "====O-----" {
+		t.Errorf("New sliders should follow the global capabilities, got %q", view)
+	}
+
+	// Per-slider capabilities win
+	slider := New(NewState(WithValue(50)), WithWidth(10), WithTerminalCapabilities(FullTerminal))
+	if !strings.Contains(slider.View(), "●") {
+		t.Error("Expected Unicode symbols with FullTerminal")
+	}
+}
+
+func TestSlider_ASCIIFallback(t *testing.T) {
+	ascii := TerminalCapabilities{Profile: termenv.ANSI256, Unicode: false}
+
+	slider := New(NewState(WithValue(50)), WithWidth(10), WithTerminalCapabilities(ascii),
+		WithBorder(BorderRounded))
+	view := slider.View()
+	if !strings.Contains(view, "====O-----") {
+		t.Errorf("Expected ASCII track, got %q", view)
+	}
+	if !strings.Contains(view, "+") || strings.Contains(view, "╭") {
+		t.Errorf("Expected ASCII border, got %q", view)
+	}
+
+	// ASCII symbols are kept
+	custom := Symbols{Filled: "#", Empty: ".", Handle: "@"}
+	slider = New(NewState(), WithSymbols(custom), WithTerminalCapabilities(ascii))
+	if slider.symbols != custom {
+		t.Errorf("ASCII symbols should be kept, got %+v", slider.symbols)
+	}
+}
+
+func TestSlider_MonochromeFallback(t *testing.T) {
+	mono := TerminalCapabilities{Profile: termenv.Ascii, Unicode: true}
+
+	// The segmented preset tells filled and empty apart only by color
+	slider := New(NewState(WithValue(50)), append(StyleSegmented().Apply(),
+		WithTerminalCapabilities(mono))...)
+
+	if slider.symbols.Filled == slider.symbols.Empty {
+		t.Errorf("Expected distinct glyphs without color, got %+v", slider.symbols)
+	}
+	if _, ok := slider.filledStyle.GetForeground().(lipgloss.NoColor); !ok {
+		t.Error("Colors should be removed without color")
+	}
+	if !slider.filledStyle.GetBold() || !slider.handleStyle.GetReverse() {
+		t.Error("Expected bold fill and reverse handle without color")
+	}
+
+	// With color the preset is unchanged
+	slider = New(NewState(), append(StyleSegmented().Apply(),
+		WithTerminalCapabilities(FullTerminal))...)
+	if slider.symbols != StyleSegmented().Symbols {
+		t.Errorf("Expected preset symbols with color, got %+v", slider.symbols)
+	}
+}
+
+func TestSlider_LimitedProfileFallback(t *testing.T) {
+	same := Symbols{Filled: "█", Empty: "█", Handle: "●"}
+	opts := func(caps TerminalCapabilities) []SliderOption {
+		return []SliderOption{
+			WithSymbols(same),
+			WithFilledStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))),
+			WithEmptyStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#f80808"))),
+			WithTerminalCapabilities(caps),
+		}
+	}
+
+	// Both reds become color 196 with 256 colors
+	slider := New(NewState(), opts(TerminalCapabilities{Profile: termenv.ANSI256, Unicode: true})...)
+	if slider.symbols.Empty == "█" {
+		t.Error("Colors that collapse under the profile should get distinct glyphs")
+	}
+
+	slider = New(NewState(), opts(FullTerminal)...)
+	if slider.symbols != same {
+		t.Errorf("Distinct true colors need no fallback, got %+v", slider.symbols)
+	}
+
+	// Parts that were never told apart by color are left alone
+	slider = New(NewState(), WithSymbols(same),
+		WithTerminalCapabilities(TerminalCapabilities{Profile: termenv.Ascii, Unicode: true}))
+	if slider.symbols != same {
+		t.Errorf("Uncolored symbols should be kept, got %+v", slider.symbols)
+	}
+}
@@ -0,0 +1,644 @@
+package tuslide
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// Theme is the file form of a SliderStyle, so styles can be tuned without
+// recompiling. Colors are "#rrggbb", "#rgb" or an ANSI color from 0 to
+// 255. In YAML:
+//
+//	name: Fire
+//	aliases: [flames]
+//	symbol_set: block
+//	symbols:
+//	  handle: "●"
+//	filled:
+//	  foreground: "#ff6b6b"
+//	  bold: true
+//	empty:
+//	  foreground: "240"
+//	border: rounded
+//	gradient:
+//	  from: "#ff0000"
+//	  to: "#ffd700"
+type Theme struct {
+	Name    string   `json:"name" yaml:"name" toml:"name"`
+	Aliases []string `json:"aliases,omitempty" yaml:"aliases,omitempty" toml:"aliases,omitempty"`
+
+	// SymbolSet names a registered symbol set to start from; Symbols
+	// replaces the parts it sets. Without either, DefaultSymbols is used.
+	SymbolSet string       `json:"symbol_set,omitempty" yaml:"symbol_set,omitempty" toml:"symbol_set,omitempty"`
+	Symbols   ThemeSymbols `json:"symbols,omitzero" yaml:"symbols,omitempty" toml:"symbols,omitempty"`
+
+	Filled ThemeStyle `json:"filled,omitzero" yaml:"filled,omitempty" toml:"filled,omitempty"`
+	Empty  ThemeStyle `json:"empty,omitzero" yaml:"empty,omitempty" toml:"empty,omitempty"`
+	Handle ThemeStyle `json:"handle,omitzero" yaml:"handle,omitempty" toml:"handle,omitempty"`
+	Label  ThemeStyle `json:"label,omitzero" yaml:"label,omitempty" toml:"label,omitempty"`
+	Value  ThemeStyle `json:"value,omitzero" yaml:"value,omitempty" toml:"value,omitempty"`
+
+	Segmented    bool `json:"segmented,omitempty" yaml:"segmented,omitempty" toml:"segmented,omitempty"`
+	SegmentCount int  `json:"segment_count,omitempty" yaml:"segment_count,omitempty" toml:"segment_count,omitzero"`
+	SegmentGap   int  `json:"segment_gap,omitempty" yaml:"segment_gap,omitempty" toml:"segment_gap,omitzero"`
+
+	// Border is none, rounded, normal, thick or double
+	Border      string         `json:"border,omitempty" yaml:"border,omitempty" toml:"border,omitempty"`
+	BorderColor string         `json:"border_color,omitempty" yaml:"border_color,omitempty" toml:"border_color,omitempty"`
+	Gradient    *ThemeGradient `json:"gradient,omitempty" yaml:"gradient,omitempty" toml:"gradient,omitempty"`
+}
+
+// ThemeSymbols are the characters of a Theme. Unset ones keep the symbol
+// set's; set an empty handle for progress bars.
+type ThemeSymbols struct {
+	Filled *string `json:"filled,omitempty" yaml:"filled,omitempty" toml:"filled,omitempty"`
+	Empty  *string `json:"empty,omitempty" yaml:"empty,omitempty" toml:"empty,omitempty"`
+	Handle *string `json:"handle,omitempty" yaml:"handle,omitempty" toml:"handle,omitempty"`
+}
+
+// ThemeStyle is the file form of a lipgloss.Style.
+type ThemeStyle struct {
+	Foreground    string `json:"foreground,omitempty" yaml:"foreground,omitempty" toml:"foreground,omitempty"`
+	Background    string `json:"background,omitempty" yaml:"background,omitempty" toml:"background,omitempty"`
+	Bold          bool   `json:"bold,omitempty" yaml:"bold,omitempty" toml:"bold,omitempty"`
+	Italic        bool   `json:"italic,omitempty" yaml:"italic,omitempty" toml:"italic,omitempty"`
+	Underline     bool   `json:"underline,omitempty" yaml:"underline,omitempty" toml:"underline,omitempty"`
+	Strikethrough bool   `json:"strikethrough,omitempty" yaml:"strikethrough,omitempty" toml:"strikethrough,omitempty"`
+	Faint         bool   `json:"faint,omitempty" yaml:"faint,omitempty" toml:"faint,omitempty"`
+	Reverse       bool   `json:"reverse,omitempty" yaml:"reverse,omitempty" toml:"reverse,omitempty"`
+	Blink         bool   `json:"blink,omitempty" yaml:"blink,omitempty" toml:"blink,omitempty"`
+}
+
+// ThemeGradient is the file form of a Gradient.
+type ThemeGradient struct {
+	From string `json:"from" yaml:"from" toml:"from"`
+	To   string `json:"to" yaml:"to" toml:"to"`
+}
+
+// ThemeFormat is the file format of a Theme.
+type ThemeFormat string
+
+const (
+	// ThemeJSON is JSON.
+	ThemeJSON ThemeFormat = "json"
+	// ThemeYAML is YAML.
+	ThemeYAML ThemeFormat = "yaml"
+	// ThemeTOML is TOML.
+	ThemeTOML ThemeFormat = "toml"
+)
+
+// ThemeFormatForPath returns the format of a theme file from its
+// extension: .json, .yaml, .yml or .toml.
+func ThemeFormatForPath(path string) (ThemeFormat, bool) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ThemeJSON, true
+	case ".yaml", ".yml":
+		return ThemeYAML, true
+	case ".toml":
+		return ThemeTOML, true
+	}
+	return "", false
+}
+
+// ThemeError is a problem with a theme, at the key where it was found.
+type ThemeError struct {
+	File string // Theme file, if the theme was loaded from one
+	Key  string // Dotted path of the key, e.g. "filled.foreground"; empty for the whole theme
+	Err  error
+}
+
+// Error returns the error with its file and key, e.g.
+// `tuslide: fire.yaml: filled.foreground: invalid color "#ff00zz"`.
+func (e *ThemeError) Error() string {
+	var b strings.Builder
+	b.WriteString("tuslide: ")
+	if e.File != "" {
+		b.WriteString(e.File + ": ")
+	}
+	if e.Key != "" {
+		b.WriteString(e.Key + ": ")
+	}
+	b.WriteString(e.Err.Error())
+	return b.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *ThemeError) Unwrap() error {
+	return e.Err
+}
+
+// errUnknownKey is the error for keys that aren't part of the format.
+var errUnknownKey = errors.New("unknown key")
+
+// borderNames maps theme border names to styles.
+var borderNames = map[string]BorderStyle{
+	"none":    BorderNone,
+	"rounded": BorderRounded,
+	"normal":  BorderNormal,
+	"thick":   BorderThick,
+	"double":  BorderDouble,
+}
+
+// NewTheme returns the theme of a style, e.g. to save a built-in style as
+// a starting point. Colors that aren't plain or ANSI colors, such as
+// adaptive ones, are saved as their RGB value.
+func NewTheme(style SliderStyle) Theme {
+	t := Theme{
+		Name: style.Name,
+		Symbols: ThemeSymbols{
+			Filled: &style.Symbols.Filled,
+			Empty:  &style.Symbols.Empty,
+			Handle: &style.Symbols.Handle,
+		},
+		Filled:       newThemeStyle(style.FilledStyle),
+		Empty:        newThemeStyle(style.EmptyStyle),
+		Handle:       newThemeStyle(style.HandleStyle),
+		Label:        newThemeStyle(style.LabelStyle),
+		Value:        newThemeStyle(style.ValueStyle),
+		Segmented:    style.Segmented,
+		SegmentCount: style.SegmentCount,
+		SegmentGap:   style.SegmentGap,
+		BorderColor:  string(style.BorderColor),
+	}
+
+	if style.Border != BorderNone {
+		for name, border := range borderNames {
+			if border == style.Border {
+				t.Border = name
+			}
+		}
+	}
+	if !style.Gradient.IsZero() {
+		t.Gradient = &ThemeGradient{
+			From: colorString(style.Gradient.From),
+			To:   colorString(style.Gradient.To),
+		}
+	}
+	return t
+}
+
+// newThemeStyle returns the theme form of a lipgloss style.
+func newThemeStyle(style lipgloss.Style) ThemeStyle {
+	return ThemeStyle{
+		Foreground:    colorString(style.GetForeground()),
+		Background:    colorString(style.GetBackground()),
+		Bold:          style.GetBold(),
+		Italic:        style.GetItalic(),
+		Underline:     style.GetUnderline(),
+		Strikethrough: style.GetStrikethrough(),
+		Faint:         style.GetFaint(),
+		Reverse:       style.GetReverse(),
+		Blink:         style.GetBlink(),
+	}
+}
+
+// colorString returns the theme form of a color, or "" for none.
+func colorString(c lipgloss.TerminalColor) string {
+	switch v := c.(type) {
+	case nil, lipgloss.NoColor:
+		return ""
+	case lipgloss.Color:
+		return string(v)
+	case lipgloss.ANSIColor:
+		return strconv.Itoa(int(v))
+	}
+	if rgb, ok := rgbOf(c); ok {
+		return rgb.Hex()
+	}
+	return ""
+}
+
+// Style validates the theme and returns its SliderStyle. Each problem is a
+// *ThemeError naming its key; all of them are returned together.
+func (t Theme) Style() (SliderStyle, error) {
+	var errs []error
+	fail := func(key string, format string, args ...any) {
+		errs = append(errs, &ThemeError{Key: key, Err: fmt.Errorf(format, args...)})
+	}
+
+	if registryKey(t.Name) == "" {
+		fail("name", "missing")
+	}
+
+	symbols := DefaultSymbols()
+	if t.SymbolSet != "" {
+		if set, ok := LookupSymbolSet(t.SymbolSet); ok {
+			symbols = set.ToSymbols()
+		} else {
+			fail("symbol_set", "unknown symbol set %q", t.SymbolSet)
+		}
+	}
+	for _, sym := range []struct {
+		key   string
+		value *string
+		dst   *string
+	}{
+		{"symbols.filled", t.Symbols.Filled, &symbols.Filled},
+		{"symbols.empty", t.Symbols.Empty, &symbols.Empty},
+		{"symbols.handle", t.Symbols.Handle, &symbols.Handle},
+	} {
+		switch {
+		case sym.value == nil:
+		case strings.ContainsAny(*sym.value, "\n\r\t"):
+			fail(sym.key, "must be a single line")
+		default:
+			*sym.dst = *sym.value
+		}
+	}
+
+	color := func(key, value string) lipgloss.Color {
+		if value == "" {
+			return ""
+		}
+		if _, ok := DefaultTerminalPalette.parse(value); !ok {
+			fail(key, "invalid color %q: use \"#rrggbb\", \"#rgb\" or 0-255", value)
+		}
+		return lipgloss.Color(value)
+	}
+	style := func(key string, ts ThemeStyle) lipgloss.Style {
+		// Only set attributes, so unset ones still inherit
+		s := lipgloss.NewStyle()
+		if ts.Bold {
+			s = s.Bold(true)
+		}
+		if ts.Italic {
+			s = s.Italic(true)
+		}
+		if ts.Underline {
+			s = s.Underline(true)
+		}
+		if ts.Strikethrough {
+			s = s.Strikethrough(true)
+		}
+		if ts.Faint {
+			s = s.Faint(true)
+		}
+		if ts.Reverse {
+			s = s.Reverse(true)
+		}
+		if ts.Blink {
+			s = s.Blink(true)
+		}
+		if fg := color(key+".foreground", ts.Foreground); fg != "" {
+			s = s.Foreground(fg)
+		}
+		if bg := color(key+".background", ts.Background); bg != "" {
+			s = s.Background(bg)
+		}
+		return s
+	}
+
+	result := SliderStyle{
+		Name:         t.Name,
+		Symbols:      symbols,
+		FilledStyle:  style("filled", t.Filled),
+		EmptyStyle:   style("empty", t.Empty),
+		HandleStyle:  style("handle", t.Handle),
+		LabelStyle:   style("label", t.Label),
+		ValueStyle:   style("value", t.Value),
+		Segmented:    t.Segmented,
+		SegmentCount: t.SegmentCount,
+		SegmentGap:   t.SegmentGap,
+		BorderColor:  color("border_color", t.BorderColor),
+	}
+
+	if t.SegmentCount < 0 {
+		fail("segment_count", "must not be negative")
+	}
+	if t.SegmentGap < 0 {
+		fail("segment_gap", "must not be negative")
+	}
+	if t.Border != "" {
+		border, ok := borderNames[strings.ToLower(t.Border)]
+		if !ok {
+			fail("border", "unknown border %q: use none, rounded, normal, thick or double", t.Border)
+		}
+		result.Border = border
+	}
+	if g := t.Gradient; g != nil {
+		if g.From == "" {
+			fail("gradient.from", "missing")
+		}
+		if g.To == "" {
+			fail("gradient.to", "missing")
+		}
+		from, to := color("gradient.from", g.From), color("gradient.to", g.To)
+		if from != "" && to != "" {
+			result.Gradient = Gradient{From: from, To: to}
+		}
+	}
+
+	if len(errs) > 0 {
+		return SliderStyle{}, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+// ParseTheme decodes a theme. Keys that aren't part of the format and
+// values of the wrong type are reported as *ThemeErrors naming the key.
+// The theme isn't validated; see Theme.Style.
+func ParseTheme(data []byte, format ThemeFormat) (Theme, error) {
+	var raw map[string]any
+	var err error
+	switch format {
+	case ThemeJSON:
+		err = json.Unmarshal(data, &raw)
+	case ThemeYAML:
+		err = yaml.Unmarshal(data, &raw)
+	case ThemeTOML:
+		err = toml.Unmarshal(data, &raw)
+	default:
+		return Theme{}, fmt.Errorf("tuslide: unknown theme format %q", format)
+	}
+	if err != nil {
+		return Theme{}, &ThemeError{Err: err}
+	}
+
+	var t Theme
+	if err := decodeThemeValue(reflect.ValueOf(&t).Elem(), raw, ""); err != nil {
+		return Theme{}, err
+	}
+	return t, nil
+}
+
+// decodeThemeValue stores raw, decoded from any of the formats, in v. Keys
+// are matched by the fields' JSON names, which all formats share.
+func decodeThemeValue(v reflect.Value, raw any, key string) error {
+	wrong := func(expected string) error {
+		return &ThemeError{Key: key, Err: fmt.Errorf("expected %s, got %v", expected, raw)}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(v.Type().Elem())
+		if err := decodeThemeValue(elem.Elem(), raw, key); err != nil {
+			return err
+		}
+		v.Set(elem)
+
+	case reflect.Struct:
+		m, ok := raw.(map[string]any)
+		if !ok {
+			if raw == nil && key == "" {
+				return nil // An empty document
+			}
+			return wrong("a map of keys")
+		}
+		fields := make(map[string]int, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+			fields[name] = i
+		}
+
+		names := make([]string, 0, len(m))
+		for name := range m {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var errs []error
+		for _, name := range names {
+			path := name
+			if key != "" {
+				path = key + "." + name
+			}
+			i, ok := fields[name]
+			if !ok {
+				errs = append(errs, &ThemeError{Key: path, Err: errUnknownKey})
+				continue
+			}
+			if err := decodeThemeValue(v.Field(i), m[name], path); err != nil {
+				errs = append(errs, splitErrors(err)...)
+			}
+		}
+		return errors.Join(errs...)
+
+	case reflect.String:
+		s, ok := raw.(string)
+		if !ok {
+			return wrong("a string")
+		}
+		v.SetString(s)
+
+	case reflect.Bool:
+		b, ok := raw.(bool)
+		if !ok {
+			return wrong("true or false")
+		}
+		v.SetBool(b)
+
+	case reflect.Int:
+		var n int64
+		switch x := raw.(type) {
+		case int:
+			n = int64(x)
+		case int64:
+			n = x
+		case float64:
+			if x != math.Trunc(x) || math.Abs(x) > math.MaxInt32 {
+				return wrong("a whole number")
+			}
+			n = int64(x)
+		default:
+			return wrong("a whole number")
+		}
+		v.SetInt(n)
+
+	case reflect.Slice:
+		list, ok := raw.([]any)
+		if !ok {
+			return wrong("a list")
+		}
+		s := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i, item := range list {
+			if err := decodeThemeValue(s.Index(i), item, fmt.Sprintf("%s[%d]", key, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+	}
+	return nil
+}
+
+// Marshal encodes the theme in a format.
+func (t Theme) Marshal(format ThemeFormat) ([]byte, error) {
+	switch format {
+	case ThemeJSON:
+		data, err := json.MarshalIndent(t, "", "  ")
+		return append(data, '\n'), err
+	case ThemeYAML:
+		return yaml.Marshal(t)
+	case ThemeTOML:
+		var buf bytes.Buffer
+		enc := toml.NewEncoder(&buf)
+		enc.Indent = ""
+		err := enc.Encode(t)
+		return buf.Bytes(), err
+	}
+	return nil, fmt.Errorf("tuslide: unknown theme format %q", format)
+}
+
+// LoadTheme reads a theme file, in the format of its extension. Errors
+// name the file.
+func LoadTheme(path string) (Theme, error) {
+	return loadTheme(os.ReadFile, path, path)
+}
+
+// SaveTheme writes a theme file, in the format of its extension.
+func SaveTheme(path string, t Theme) error {
+	format, ok := ThemeFormatForPath(path)
+	if !ok {
+		return &ThemeError{File: path, Err: errUnknownFormat}
+	}
+	data, err := t.Marshal(format)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// errUnknownFormat is the error for files without a theme extension.
+var errUnknownFormat = errors.New("unknown theme format: use .json, .yaml, .yml or .toml")
+
+// loadTheme reads and parses the theme at path with read, naming it file
+// in errors.
+func loadTheme(read func(string) ([]byte, error), path, file string) (Theme, error) {
+	format, ok := ThemeFormatForPath(path)
+	if !ok {
+		return Theme{}, &ThemeError{File: file, Err: errUnknownFormat}
+	}
+	data, err := read(path)
+	if err != nil {
+		return Theme{}, err
+	}
+	t, err := ParseTheme(data, format)
+	return t, inFile(err, file)
+}
+
+// inFile records the file in the ThemeErrors of err.
+func inFile(err error, file string) error {
+	for _, e := range splitErrors(err) {
+		var te *ThemeError
+		if errors.As(e, &te) {
+			te.File = file
+		}
+	}
+	return err
+}
+
+// splitErrors returns the errors joined in err, or err alone.
+func splitErrors(err error) []error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+	var errs []error
+	for _, e := range joined.Unwrap() {
+		errs = append(errs, splitErrors(e)...)
+	}
+	return errs
+}
+
+// RegisterThemes registers the styles of the theme files at the top of
+// fsys (.json, .yaml, .yml and .toml; other files are ignored) under
+// their names and aliases, replacing registered styles of the same name.
+// Themes are validated first: if any is invalid, or a name or alias is
+// taken by another style or theme, none is registered and the problems of
+// every file are returned together. Returns the names of
+// the registered styles, in file name order.
+func RegisterThemes(fsys fs.FS) ([]string, error) {
+	return registerThemes(fsys, func(name string) string { return name })
+}
+
+// RegisterThemeDir registers the theme files in a directory, like
+// RegisterThemes.
+func RegisterThemeDir(dir string) ([]string, error) {
+	return registerThemes(os.DirFS(dir), func(name string) string {
+		return filepath.Join(dir, name)
+	})
+}
+
+// registerThemes implements RegisterThemes, naming files in errors with
+// file.
+func registerThemes(fsys fs.FS, file func(name string) string) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	type loaded struct {
+		file    string
+		style   SliderStyle
+		aliases []string
+	}
+	var themes []loaded
+	var errs []error
+	defined := make(map[string]string) // Style key to the file defining it
+
+	for _, entry := range entries {
+		if _, ok := ThemeFormatForPath(entry.Name()); entry.IsDir() || !ok {
+			continue
+		}
+		name := file(entry.Name())
+
+		t, err := loadTheme(func(path string) ([]byte, error) {
+			return fs.ReadFile(fsys, path)
+		}, entry.Name(), name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		style, err := t.Style()
+		if err != nil {
+			errs = append(errs, inFile(err, name))
+			continue
+		}
+
+		if first, ok := defined[registryKey(style.Name)]; ok {
+			errs = append(errs, &ThemeError{File: name, Key: "name",
+				Err: fmt.Errorf("%q is also defined in %s", style.Name, first)})
+			continue
+		}
+		defined[registryKey(style.Name)] = name
+		themes = append(themes, loaded{name, style, t.Aliases})
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	items := make([]registryItem[SliderStyle], len(themes))
+	for i, t := range themes {
+		items[i] = registryItem[SliderStyle]{t.style.Name, t.style, t.aliases}
+	}
+	for _, c := range styleRegistry.registerAll("style", items) {
+		key := "name"
+		if c.alias >= 0 {
+			key = fmt.Sprintf("aliases[%d]", c.alias)
+		}
+		errs = append(errs, &ThemeError{File: themes[c.item].file, Key: key, Err: c.err})
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	names := make([]string, len(themes))
+	for i, t := range themes {
+		names[i] = t.style.Name
+	}
+	return names, nil
+}
@@ -0,0 +1,285 @@
+package tuslide
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestTheme_RoundTrip(t *testing.T) {
+	for _, style := range AllStyles() {
+		for _, format := range []ThemeFormat{ThemeJSON, ThemeYAML, ThemeTOML} {
+			data, err := NewTheme(style).Marshal(format)
+			if err != nil {
+				t.Fatalf("%s %s: %v", style.Name, format, err)
+			}
+			theme, err := ParseTheme(data, format)
+			if err != nil {
+				t.Fatalf("%s %s: %v\n%s", style.Name, format, err, data)
+			}
+			got, err := theme.Style()
+			if err != nil {
+				t.Fatalf("%s %s: %v", style.Name, format, err)
+			}
+			if !reflect.DeepEqual(got, style) {
+				t.Errorf("%s %s: round trip changed the style:\n%+v\n%+v", style.Name, format, got, style)
+			}
+		}
+	}
+}
+
+func TestParseTheme(t *testing.T) {
+	docs := map[ThemeFormat]string{
+		ThemeJSON: `{"name": "Fire", "symbol_set": "block", "symbols": {"handle": "●"},
+			"filled": {"foreground": "#ff6b6b", "bold": true}, "border": "rounded",
+			"segment_count": 8, "gradient": {"from": "#ff0000", "to": "#ffd700"}}`,
+		ThemeYAML: `
+name: Fire
+symbol_set: block
+symbols:
+  handle: "●"
+filled:
+  foreground: "#ff6b6b"
+  bold: true
+border: rounded
+segment_count: 8
+gradient:
+  from: "#ff0000"
+  to: "#ffd700"
+`,
+		ThemeTOML: `
+name = "Fire"
+symbol_set = "block"
+border = "rounded"
+segment_count = 8
+
+[symbols]
+handle = "●"
+
+[filled]
+foreground = "#ff6b6b"
+bold = true
+
+[gradient]
+from = "#ff0000"
+to = "#ffd700"
+`,
+	}
+
+	for format, doc := range docs {
+		theme, err := ParseTheme([]byte(doc), format)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		style, err := theme.Style()
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+
+		symbols := Symbols{Filled: SymbolSetBlock.Filled, Empty: SymbolSetBlock.Empty, Handle: "●"}
+		if style.Symbols != symbols {
+			t.Errorf("%s: unexpected symbols %+v", format, style.Symbols)
+		}
+		if style.FilledStyle.GetForeground() != lipgloss.Color("#ff6b6b") || !style.FilledStyle.GetBold() {
+			t.Errorf("%s: unexpected filled style", format)
+		}
+		if style.Border != BorderRounded || style.SegmentCount != 8 {
+			t.Errorf("%s: unexpected border or segments", format)
+		}
+		if style.Gradient != (Gradient{From: lipgloss.Color("#ff0000"), To: lipgloss.Color("#ffd700")}) {
+			t.Errorf("%s: unexpected gradient %+v", format, style.Gradient)
+		}
+	}
+}
+
+// themeErrorKeys returns the keys of the ThemeErrors in err.
+func themeErrorKeys(err error) []string {
+	var keys []string
+	for _, e := range splitErrors(err) {
+		var te *ThemeError
+		if errors.As(e, &te) {
+			keys = append(keys, te.Key)
+		}
+	}
+	return keys
+}
+
+func TestParseTheme_Errors(t *testing.T) {
+	doc := "name: Fire\nfilled:\n  forground: red\n  bold: yes\nsegment_count: 1.5\n"
+	_, err := ParseTheme([]byte(doc), ThemeYAML)
+
+	expected := []string{"filled.bold", "filled.forground", "segment_count"}
+	if keys := themeErrorKeys(err); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected errors at %q, got %v", expected, err)
+	}
+	if !strings.Contains(err.Error(), `tuslide: filled.forground: unknown key`) {
+		t.Errorf("Unexpected message: %v", err)
+	}
+
+	if _, err := ParseTheme([]byte(`{"name": `), ThemeJSON); err == nil {
+		t.Error("Expected a syntax error")
+	}
+	if _, err := ParseTheme([]byte(`aliases = "x"`), ThemeTOML); !reflect.DeepEqual(themeErrorKeys(err), []string{"aliases"}) {
+		t.Errorf("Expected an error at aliases, got %v", err)
+	}
+}
+
+func TestTheme_Validation(t *testing.T) {
+	theme := Theme{
+		SymbolSet: "sparkles",
+		Symbols:   ThemeSymbols{Handle: ptr("●\n●")},
+		Filled:    ThemeStyle{Foreground: "#ff00zz"},
+		Value:     ThemeStyle{Background: "256"},
+		Border:    "wavy",
+		Gradient:  &ThemeGradient{From: "1"},
+	}
+
+	_, err := theme.Style()
+	expected := []string{"name", "symbol_set", "symbols.handle", "filled.foreground",
+		"value.background", "border", "gradient.to"}
+	if keys := themeErrorKeys(err); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected errors at %q, got %v", expected, err)
+	}
+	if !strings.Contains(err.Error(), `tuslide: filled.foreground: invalid color "#ff00zz"`) {
+		t.Errorf("Unexpected message: %v", err)
+	}
+}
+
+func TestLoadAndSaveTheme(t *testing.T) {
+	dir := t.TempDir()
+	theme := NewTheme(StyleNeon())
+	theme.Aliases = []string{"glow"}
+
+	for _, name := range []string{"neon.json", "neon.yml", "neon.toml"} {
+		path := filepath.Join(dir, name)
+		if err := SaveTheme(path, theme); err != nil {
+			t.Fatal(err)
+		}
+		loaded, err := LoadTheme(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(loaded, theme) {
+			t.Errorf("%s: expected %+v, got %+v", name, theme, loaded)
+		}
+	}
+
+	if err := SaveTheme(filepath.Join(dir, "neon.txt"), theme); err == nil {
+		t.Error("Expected an error for an unknown extension")
+	}
+}
+
+func TestRegisterThemes(t *testing.T) {
+	fsys := fstest.MapFS{
+		"ember.yaml": {Data: []byte("name: Theme Test Ember\naliases: [theme-test-coals]\nfilled:\n  foreground: \"#ff4500\"\n")},
+		"frost.json": {Data: []byte(`{"name": "Theme Test Frost", "symbol_set": "ascii"}`)},
+		"README.md":  {Data: []byte("not a theme")},
+		"sub/x.toml": {Data: []byte(`name = 1`)},
+	}
+
+	names, err := RegisterThemes(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"Theme Test Ember", "Theme Test Frost"}) {
+		t.Errorf("Unexpected names %q", names)
+	}
+	if style, ok := LookupStyle("theme-test-coals"); !ok || style.FilledStyle.GetForeground() != lipgloss.Color("#ff4500") {
+		t.Error("Expected to find the theme by alias")
+	}
+
+	// One bad file registers nothing and names every problem
+	fsys = fstest.MapFS{
+		"good.toml": {Data: []byte(`name = "Theme Test Good"`)},
+		"bad.json":  {Data: []byte(`{"name": "Theme Test Bad", "border": "wavy"}`)},
+		"dup.yaml":  {Data: []byte(`name: theme test good`)},
+	}
+	_, err = RegisterThemes(fsys)
+	if err == nil {
+		t.Fatal("Expected errors")
+	}
+	for _, msg := range []string{`bad.json: border: unknown border "wavy"`, `good.toml: name: "Theme Test Good" is also defined in dup.yaml`} {
+		if !strings.Contains(err.Error(), msg) {
+			t.Errorf("Expected %q in %v", msg, err)
+		}
+	}
+	if _, ok := LookupStyle("Theme Test Good"); ok {
+		t.Error("Nothing should be registered when a theme is invalid")
+	}
+}
+
+func TestRegisterThemes_AliasConflicts(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		file string
+		key  string
+	}{
+		{"between themes", fstest.MapFS{
+			"a.json": {Data: []byte(`{"name": "Theme Test A1", "aliases": ["theme-test-shared"]}`)},
+			"b.json": {Data: []byte(`{"name": "Theme Test B1", "aliases": ["theme-test-b", "theme-test-shared"]}`)},
+		}, "b.json", "aliases[1]"},
+		{"with a built-in", fstest.MapFS{
+			"a.json": {Data: []byte(`{"name": "Theme Test A1"}`)},
+			"b.json": {Data: []byte(`{"name": "Theme Test B1", "aliases": ["ocean"]}`)},
+		}, "b.json", "aliases[0]"},
+		{"with another theme's name", fstest.MapFS{
+			"a.json": {Data: []byte(`{"name": "Theme Test A1", "aliases": ["theme test b1"]}`)},
+			"b.json": {Data: []byte(`{"name": "Theme Test B1"}`)},
+		}, "b.json", "name"},
+	}
+
+	for _, tt := range tests {
+		_, err := RegisterThemes(tt.fsys)
+		var te *ThemeError
+		if !errors.As(err, &te) || te.File != tt.file || te.Key != tt.key {
+			t.Errorf("%s: expected a ThemeError at %s %s, got %v", tt.name, tt.file, tt.key, err)
+		}
+		for _, name := range []string{"Theme Test A1", "Theme Test B1"} {
+			if _, ok := LookupStyle(name); ok {
+				t.Errorf("%s: %q should not be registered", tt.name, name)
+			}
+		}
+	}
+	if style, _ := LookupStyle("ocean"); style.Name != "Ocean" {
+		t.Error("The built-in style should keep its name")
+	}
+}
+
+func TestSliderStyle_Extras(t *testing.T) {
+	style := StyleOcean()
+	style.Border = BorderDouble
+	style.SegmentGap = 2
+	style.Gradient = Gradient{From: lipgloss.Color("#000000"), To: lipgloss.Color("#ffffff")}
+
+	for _, slider := range []*Slider{
+		New(NewState(), WithStyle(style)),
+		New(NewState(), style.Apply()...),
+	} {
+		if slider.borderStyle != BorderDouble || slider.segmentGap != 2 {
+			t.Error("Expected the style's border and gap")
+		}
+		if fg := slider.filledStyleAt(slider.filledStyle, 0, 3).GetForeground(); fg != lipgloss.Color("#000000") {
+			t.Errorf("Expected the gradient's start, got %v", fg)
+		}
+		if fg := slider.filledStyleAt(slider.filledStyle, 2, 3).GetForeground(); fg != lipgloss.Color("#ffffff") {
+			t.Errorf("Expected the gradient's end, got %v", fg)
+		}
+	}
+
+	// Unset extras keep the slider's own settings
+	slider := New(NewState(), WithBorder(BorderRounded), WithStyle(StyleOcean()))
+	if slider.borderStyle != BorderRounded {
+		t.Error("A style without a border should keep the slider's")
+	}
+}
+
+// ptr returns a pointer to s.
+func ptr(s string) *string {
+	return &s
+}
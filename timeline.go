@@ -0,0 +1,397 @@
+package tuslide
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Clip is a piece of a timeline. Clips are evaluated by seeking to a point
+// in time, so frames can be skipped, replayed or played backwards without
+// accumulating drift.
+type Clip interface {
+	// Duration returns the length of the clip.
+	Duration() time.Duration
+	// Seek applies the clip's values at time t, where 0 <= t <= Duration.
+	Seek(t time.Duration)
+}
+
+// Keyframe is one segment of a keyframe clip: after holding the previous
+// value for Delay, the value moves to Value over Duration using Easing.
+type Keyframe struct {
+	Value    float64
+	Duration time.Duration
+	Delay    time.Duration
+	Easing   EasingFunc // nil uses EaseOutQuad
+}
+
+// Key creates a keyframe that reaches value after duration.
+func Key(value float64, duration time.Duration) Keyframe {
+	return Keyframe{Value: value, Duration: duration}
+}
+
+// WithEase returns a copy of the keyframe using the given easing.
+func (k Keyframe) WithEase(f EasingFunc) Keyframe {
+	k.Easing = f
+	return k
+}
+
+// WithDelay returns a copy of the keyframe that holds the previous value
+// for d before moving.
+func (k Keyframe) WithDelay(d time.Duration) Keyframe {
+	k.Delay = d
+	return k
+}
+
+// keyframeClip animates one SliderState through a list of keyframes.
+type keyframeClip struct {
+	state    *SliderState
+	frames   []Keyframe
+	from     float64
+	captured bool // from holds the value the state had when first seeked
+}
+
+// Keyframes creates a clip that moves state through frames in order,
+// starting from whatever value state has when the clip first plays.
+// Use a zero-duration first keyframe to start from a fixed value.
+func Keyframes(state *SliderState, frames ...Keyframe) Clip {
+	return &keyframeClip{state: state, frames: frames}
+}
+
+// Duration returns the total length of all delays and segments.
+func (c *keyframeClip) Duration() time.Duration {
+	var d time.Duration
+	for _, k := range c.frames {
+		d += k.Delay + k.Duration
+	}
+	return d
+}
+
+// Seek sets the state to its value at time t.
+func (c *keyframeClip) Seek(t time.Duration) {
+	if !c.captured {
+		c.from = c.state.Value()
+		c.captured = true
+	}
+
+	value := c.from
+	for _, k := range c.frames {
+		if t < k.Delay {
+			break
+		}
+		t -= k.Delay
+
+		if t >= k.Duration {
+			value = k.Value
+			t -= k.Duration
+			continue
+		}
+
+		easing := k.Easing
+		if easing == nil {
+			easing = EaseOutQuad
+		}
+		value = Lerp(value, k.Value, easing(float64(t)/float64(k.Duration)))
+		break
+	}
+
+	c.state.SetValue(value)
+}
+
+// waitClip is an empty clip that only takes time.
+type waitClip time.Duration
+
+// Wait creates a clip that does nothing for d, for pauses in a Sequence.
+func Wait(d time.Duration) Clip {
+	return waitClip(d)
+}
+
+// Duration returns the wait time.
+func (w waitClip) Duration() time.Duration {
+	return time.Duration(w)
+}
+
+// Seek does nothing.
+func (w waitClip) Seek(time.Duration) {}
+
+// stateClip is implemented by clips that know which states they animate.
+type stateClip interface {
+	states() []*SliderState
+}
+
+// states returns the keyframed state.
+func (c *keyframeClip) states() []*SliderState {
+	return []*SliderState{c.state}
+}
+
+// groupClip plays child clips at fixed start offsets.
+type groupClip struct {
+	clips  []Clip
+	starts []time.Duration
+	played []bool // Child has been seeked since it started
+}
+
+// newGroupClip creates a group of clips, all starting at 0.
+func newGroupClip(clips []Clip) *groupClip {
+	return &groupClip{
+		clips:  clips,
+		starts: make([]time.Duration, len(clips)),
+		played: make([]bool, len(clips)),
+	}
+}
+
+// Sequence creates a clip that plays clips one after another.
+func Sequence(clips ...Clip) Clip {
+	g := newGroupClip(clips)
+
+	var at time.Duration
+	for i, c := range clips {
+		g.starts[i] = at
+		at += c.Duration()
+	}
+
+	return g
+}
+
+// Parallel creates a clip that plays clips at the same time.
+func Parallel(clips ...Clip) Clip {
+	return Stagger(0, clips...)
+}
+
+// Stagger creates a clip that starts each clip offset after the previous
+// one, letting them overlap.
+func Stagger(offset time.Duration, clips ...Clip) Clip {
+	g := newGroupClip(clips)
+	for i := range clips {
+		g.starts[i] = time.Duration(i) * offset
+	}
+	return g
+}
+
+// Duration returns the time until the last child clip ends.
+func (g *groupClip) Duration() time.Duration {
+	var d time.Duration
+	for i, c := range g.clips {
+		d = max(d, g.starts[i]+c.Duration())
+	}
+	return d
+}
+
+// Seek seeks every child that has started by time t. Children are seeked
+// in order, so later clips win when several animate the same state.
+// Children that have played but start after t, as when a yoyo iteration
+// plays backwards past them, are rewound to their start first, latest
+// first, so earlier clips win as they did going forwards.
+func (g *groupClip) Seek(t time.Duration) {
+	for i := len(g.clips) - 1; i >= 0; i-- {
+		if t < g.starts[i] && g.played[i] {
+			g.clips[i].Seek(0)
+		}
+	}
+
+	for i, c := range g.clips {
+		if t < g.starts[i] {
+			continue
+		}
+		g.played[i] = true
+		c.Seek(min(t-g.starts[i], c.Duration()))
+	}
+}
+
+// states returns the states the children animate.
+func (g *groupClip) states() []*SliderState {
+	var states []*SliderState
+	for _, c := range g.clips {
+		if sc, ok := c.(stateClip); ok {
+			states = append(states, sc.states()...)
+		}
+	}
+	return states
+}
+
+// Timeline plays a Clip as an Animator, with optional looping and yoyo.
+// Run it on an AnimationManager, or drive it standalone with HandleTick.
+type Timeline struct {
+	id         int
+	clip       Clip
+	iterations int // 0 loops forever
+	yoyo       bool
+	startTime  time.Time
+	onComplete func()
+	clock      Clock
+	paused     bool
+	pausedAt   time.Time
+	motionSetting
+}
+
+// TimelineOption configures a Timeline.
+type TimelineOption func(*Timeline)
+
+// WithIterations sets how many times the timeline plays.
+// Zero or a negative count loops forever.
+func WithIterations(n int) TimelineOption {
+	return func(t *Timeline) {
+		t.iterations = max(n, 0)
+	}
+}
+
+// WithYoyo makes every other iteration play backwards.
+func WithYoyo() TimelineOption {
+	return func(t *Timeline) {
+		t.yoyo = true
+	}
+}
+
+// WithTimelineOnComplete sets a callback to run when the timeline finishes.
+func WithTimelineOnComplete(f func()) TimelineOption {
+	return func(t *Timeline) {
+		t.onComplete = f
+	}
+}
+
+// WithTimelineClock sets the clock the timeline reads time from.
+func WithTimelineClock(c Clock) TimelineOption {
+	return func(t *Timeline) {
+		t.clock = clockOrReal(c)
+	}
+}
+
+// WithTimelineMotion sets the timeline's motion preference. Under reduced
+// motion the timeline jumps straight to its end state.
+func WithTimelineMotion(p MotionPreference) TimelineOption {
+	return func(t *Timeline) {
+		t.motion = p
+	}
+}
+
+// NewTimeline creates a timeline that plays clip once.
+func NewTimeline(clip Clip, opts ...TimelineOption) *Timeline {
+	t := &Timeline{
+		id:         NewAnimationID(),
+		clip:       clip,
+		iterations: 1,
+		clock:      RealClock,
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+	t.states = t.States()
+	t.startTime = t.clock.Now()
+
+	return t
+}
+
+// ID returns the timeline's program-wide unique ID.
+func (t *Timeline) ID() int {
+	return t.id
+}
+
+// State returns the state the timeline drives if there is exactly one,
+// or nil. Use States for timelines that drive several.
+func (t *Timeline) State() *SliderState {
+	if states := t.States(); len(states) == 1 {
+		return states[0]
+	}
+	return nil
+}
+
+// States returns every state the timeline's keyframes drive, each once.
+// An AnimationManager replaces other animators driving any of them.
+func (t *Timeline) States() []*SliderState {
+	sc, ok := t.clip.(stateClip)
+	if !ok {
+		return nil
+	}
+
+	var states []*SliderState
+	seen := make(map[*SliderState]bool)
+	for _, s := range sc.states() {
+		if !seen[s] {
+			seen[s] = true
+			states = append(states, s)
+		}
+	}
+	return states
+}
+
+// Duration returns the length of one iteration.
+func (t *Timeline) Duration() time.Duration {
+	return t.clip.Duration()
+}
+
+// Update seeks the clip to the current time. Returns true once the last
+// iteration has finished.
+func (t *Timeline) Update() bool {
+	if t.paused {
+		return false
+	}
+
+	d := t.clip.Duration()
+	elapsed := t.clock.Now().Sub(t.startTime)
+
+	if d <= 0 || t.effectiveMotion() != MotionFull {
+		t.clip.Seek(t.end())
+		return t.finish()
+	}
+
+	iteration := int(elapsed / d)
+	if t.iterations > 0 && iteration >= t.iterations {
+		t.clip.Seek(t.end())
+		return t.finish()
+	}
+
+	pos := elapsed % d
+	if t.yoyo && iteration%2 == 1 {
+		pos = d - pos
+	}
+	t.clip.Seek(pos)
+
+	return false
+}
+
+// end returns the clip position the timeline finishes on: the end of the
+// last iteration, which is the start of the clip if that iteration played
+// backwards. Infinite timelines end after their first iteration.
+func (t *Timeline) end() time.Duration {
+	if t.yoyo && t.iterations > 0 && (t.iterations-1)%2 == 1 {
+		return 0
+	}
+	return t.clip.Duration()
+}
+
+// finish runs the completion callback and reports completion.
+func (t *Timeline) finish() bool {
+	if t.onComplete != nil {
+		t.onComplete()
+	}
+	return true
+}
+
+// Pause freezes the timeline at its current position.
+func (t *Timeline) Pause() {
+	if !t.paused {
+		t.paused = true
+		t.pausedAt = t.clock.Now()
+	}
+}
+
+// Resume continues a paused timeline from where it left off.
+func (t *Timeline) Resume() {
+	if t.paused {
+		t.paused = false
+		t.startTime = t.startTime.Add(t.clock.Now().Sub(t.pausedAt))
+	}
+}
+
+// Tick returns a command for the next timeline frame.
+func (t *Timeline) Tick() tea.Cmd {
+	return animatorTick(t.id, DefaultFPS)
+}
+
+// HandleTick advances the timeline if msg is a tick addressed to it and
+// returns the command for the next frame, or an AnimationCompleteMsg once
+// it finishes.
+func (t *Timeline) HandleTick(msg tea.Msg) tea.Cmd {
+	return handleAnimatorTick(t, msg, DefaultFPS)
+}
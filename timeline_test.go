@@ -0,0 +1,303 @@
+package tuslide
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+const ms = time.Millisecond
+
+func TestKeyframes_Seek(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clip := Keyframes(state,
+		Key(100, 100*ms).WithEase(Linear),
+		Key(50, 100*ms).WithEase(Linear).WithDelay(50*ms),
+	)
+
+	if clip.Duration() != 250*ms {
+		t.Errorf("Expected duration 250ms, got %v", clip.Duration())
+	}
+
+	tests := []struct {
+		at       time.Duration
+		expected float64
+	}{
+		{0, 0},
+		{50 * ms, 50},
+		{100 * ms, 100},
+		{125 * ms, 100}, // Holding during delay
+		{200 * ms, 75},
+		{250 * ms, 50},
+		{25 * ms, 25}, // Seeking backwards
+	}
+
+	for _, tt := range tests {
+		clip.Seek(tt.at)
+		if math.Abs(state.Value()-tt.expected) > 0.001 {
+			t.Errorf("Seek(%v): expected %f, got %f", tt.at, tt.expected, state.Value())
+		}
+	}
+}
+
+func TestKeyframes_PerSegmentEasing(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clip := Keyframes(state,
+		Key(50, 100*ms).WithEase(EaseInQuad),
+		Key(100, 100*ms).WithEase(Linear),
+	)
+
+	clip.Seek(50 * ms)
+	if math.Abs(state.Value()-12.5) > 0.001 {
+		t.Errorf("Expected EaseInQuad midpoint 12.5, got %f", state.Value())
+	}
+
+	clip.Seek(150 * ms)
+	if math.Abs(state.Value()-75) > 0.001 {
+		t.Errorf("Expected Linear midpoint 75, got %f", state.Value())
+	}
+}
+
+func TestKeyframes_StartsFromFixedValue(t *testing.T) {
+	state := NewState(WithValue(80), WithMax(100))
+	clip := Keyframes(state, Key(0, 0), Key(100, 100*ms).WithEase(Linear))
+
+	clip.Seek(0)
+	if state.Value() != 0 {
+		t.Errorf("Zero-duration first keyframe should jump to 0, got %f", state.Value())
+	}
+}
+
+func TestSequence(t *testing.T) {
+	a := NewState(WithValue(0), WithMax(100))
+	b := NewState(WithValue(0), WithMax(100))
+
+	clip := Sequence(
+		Keyframes(a, Key(100, 100*ms).WithEase(Linear)),
+		Wait(50*ms),
+		Keyframes(b, Key(100, 100*ms).WithEase(Linear)),
+	)
+
+	if clip.Duration() != 250*ms {
+		t.Errorf("Expected duration 250ms, got %v", clip.Duration())
+	}
+
+	clip.Seek(50 * ms)
+	if a.Value() != 50 || b.Value() != 0 {
+		t.Errorf("Expected a=50 b=0, got a=%f b=%f", a.Value(), b.Value())
+	}
+
+	// Skipping past the first clip still lands it on its final value
+	clip.Seek(200 * ms)
+	if a.Value() != 100 || b.Value() != 50 {
+		t.Errorf("Expected a=100 b=50, got a=%f b=%f", a.Value(), b.Value())
+	}
+}
+
+func TestSequence_SameStateLaterClipWins(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clip := Sequence(
+		Keyframes(state, Key(100, 100*ms).WithEase(Linear)),
+		Keyframes(state, Key(50, 100*ms).WithEase(Linear)),
+	)
+
+	clip.Seek(50 * ms)
+	clip.Seek(150 * ms)
+	if state.Value() != 75 {
+		t.Errorf("Second clip should continue from 100, got %f", state.Value())
+	}
+}
+
+func TestParallelAndStagger(t *testing.T) {
+	states := []*SliderState{
+		NewState(WithValue(0), WithMax(100)),
+		NewState(WithValue(0), WithMax(100)),
+		NewState(WithValue(0), WithMax(100)),
+	}
+	clips := make([]Clip, len(states))
+	for i, s := range states {
+		clips[i] = Keyframes(s, Key(100, 100*ms).WithEase(Linear))
+	}
+
+	if d := Parallel(clips...).Duration(); d != 100*ms {
+		t.Errorf("Expected parallel duration 100ms, got %v", d)
+	}
+
+	stagger := Stagger(50*ms, clips...)
+	if stagger.Duration() != 200*ms {
+		t.Errorf("Expected stagger duration 200ms, got %v", stagger.Duration())
+	}
+
+	stagger.Seek(75 * ms)
+	expected := []float64{75, 25, 0}
+	for i, s := range states {
+		if s.Value() != expected[i] {
+			t.Errorf("State %d: expected %f, got %f", i, expected[i], s.Value())
+		}
+	}
+}
+
+func TestTimeline_PlaysOnce(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	completed := false
+	tl := NewTimeline(Keyframes(state, Key(100, 100*ms).WithEase(Linear)),
+		WithTimelineOnComplete(func() { completed = true }),
+		WithTimelineClock(clock),
+	)
+
+	clock.Advance(50 * ms)
+	if tl.Update() {
+		t.Error("Timeline should not be complete half-way")
+	}
+	if state.Value() != 50 {
+		t.Errorf("Expected value 50, got %f", state.Value())
+	}
+
+	clock.Advance(100 * ms)
+	if !tl.Update() || !completed {
+		t.Error("Timeline should complete after its duration")
+	}
+	if state.Value() != 100 {
+		t.Errorf("Expected final value 100, got %f", state.Value())
+	}
+}
+
+func TestTimeline_LoopAndYoyo(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clip := Keyframes(state, Key(0, 0), Key(100, 100*ms).WithEase(Linear))
+
+	// Second iteration plays backwards
+	clock := NewManualClock(time.Time{})
+	tl := NewTimeline(clip, WithIterations(2), WithYoyo(), WithTimelineClock(clock))
+	clock.Advance(120 * ms)
+	tl.Update()
+	if state.Value() != 80 {
+		t.Errorf("Expected yoyo value 80, got %f", state.Value())
+	}
+
+	// Even iteration counts end where they started
+	clock.Advance(130 * ms)
+	if !tl.Update() {
+		t.Error("Timeline should complete after two iterations")
+	}
+	if state.Value() != 0 {
+		t.Errorf("Yoyo should end at the start value, got %f", state.Value())
+	}
+
+	// Infinite loops never complete
+	tl = NewTimeline(clip, WithIterations(0), WithTimelineClock(clock))
+	clock.Advance(10 * time.Second)
+	if tl.Update() {
+		t.Error("Infinite timeline should not complete")
+	}
+}
+
+func TestTimeline_RunsOnManager(t *testing.T) {
+	a := NewState(WithValue(0), WithMax(100))
+	b := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	tl := NewTimeline(Stagger(5*ms,
+		Keyframes(a, Key(100, 10*ms)),
+		Keyframes(b, Key(100, 10*ms)),
+	), WithTimelineClock(clock))
+
+	manager := NewAnimationManager(WithClock(clock))
+	manager.Run(tl)
+
+	// Tweens of other states run alongside
+	manager.Start(NewState(), 100, WithAnimDuration(5*ms))
+	if manager.Count() != 2 {
+		t.Fatalf("Expected 2 animations, got %d", manager.Count())
+	}
+
+	clock.Advance(15 * ms)
+	manager.Update()
+	if manager.IsRunning() {
+		t.Error("Manager should be idle once the timeline finishes")
+	}
+	if a.Value() != 100 || b.Value() != 100 {
+		t.Errorf("Expected both states at 100, got %f and %f", a.Value(), b.Value())
+	}
+}
+
+func TestTimeline_PauseResume(t *testing.T) {
+	state := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	tl := NewTimeline(Keyframes(state, Key(100, 20*ms)), WithTimelineClock(clock))
+
+	tl.Pause()
+	clock.Advance(30 * ms)
+	if tl.Update() {
+		t.Error("Paused timeline should not complete")
+	}
+
+	tl.Resume()
+	if tl.Update() {
+		t.Error("Resumed timeline should continue where it left off")
+	}
+}
+
+func TestTimeline_States(t *testing.T) {
+	a := NewState(WithValue(0), WithMax(100))
+	b := NewState(WithValue(0), WithMax(100))
+	tl := NewTimeline(Sequence(
+		Keyframes(a, Key(100, 10*ms)),
+		Wait(5*ms),
+		Parallel(Keyframes(b, Key(100, 10*ms)), Keyframes(a, Key(0, 10*ms))),
+	))
+
+	if states := tl.States(); len(states) != 2 || states[0] != a || states[1] != b {
+		t.Errorf("Expected states a and b, got %v", states)
+	}
+	if tl.State() != nil {
+		t.Error("A timeline of several states should not claim a single one")
+	}
+	if single := NewTimeline(Keyframes(a, Key(100, 10*ms))); single.State() != a {
+		t.Error("A timeline of one state should report it")
+	}
+}
+
+func TestTimeline_ReplacesOnSharedState(t *testing.T) {
+	a := NewState(WithValue(0), WithMax(100))
+	b := NewState(WithValue(0), WithMax(100))
+	manager := NewAnimationManager()
+
+	first := manager.Run(NewTimeline(Parallel(
+		Keyframes(a, Key(100, 10*ms)),
+		Keyframes(b, Key(100, 10*ms)),
+	)))
+	second := manager.Run(NewTimeline(Keyframes(b, Key(0, 10*ms))))
+	if _, ok := manager.Get(first); ok {
+		t.Error("A timeline on a shared state should replace the first")
+	}
+
+	// A tween of one of its states replaces the timeline too
+	manager.Start(b, 50)
+	if _, ok := manager.Get(second); ok || manager.Count() != 1 {
+		t.Errorf("A tween should replace the timeline, got %d animations", manager.Count())
+	}
+}
+
+func TestTimeline_YoyoRewindsLaterClips(t *testing.T) {
+	a := NewState(WithValue(0), WithMax(100))
+	b := NewState(WithValue(0), WithMax(100))
+	clock := NewManualClock(time.Time{})
+	tl := NewTimeline(Sequence(
+		Keyframes(a, Key(0, 0), Key(100, 100*ms).WithEase(Linear)),
+		Keyframes(b, Key(0, 0), Key(100, 100*ms).WithEase(Linear)),
+	), WithIterations(2), WithYoyo(), WithTimelineClock(clock))
+
+	clock.Advance(190 * ms)
+	tl.Update()
+	if a.Value() != 100 || b.Value() != 90 {
+		t.Fatalf("Expected a=100 b=90, got a=%f b=%f", a.Value(), b.Value())
+	}
+
+	// Jumping back over b's start in the backwards iteration rewinds it
+	clock.Advance(160 * ms)
+	tl.Update()
+	if a.Value() != 50 || b.Value() != 0 {
+		t.Errorf("Expected a=50 b=0, got a=%f b=%f", a.Value(), b.Value())
+	}
+}
@@ -0,0 +1,241 @@
+package tuslide
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Rect describes a rectangular region of terminal cells.
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// Contains checks if a point is within the rectangle.
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width &&
+		y >= r.Y && y < r.Y+r.Height
+}
+
+// Zone markers are zero-width CSI sequences ("ESC [ n z") that survive
+// Lip Gloss layout (borders, padding, joins) because width calculations
+// ignore escape sequences. Each marked line gets a start and an end marker,
+// so a zone's rectangle is the bounding box of all its marker pairs.
+const (
+	// trackZone is reserved for the slider's own track (see Slider.TrackRect).
+	trackZone = 0
+	// handleZone is reserved for the slider's handle (see Slider.HandleRect).
+	handleZone = 1
+	// firstUserZone is the first zone number handed out by ZoneManager.
+	firstUserZone = 2
+	// zoneMarkerBase offsets marker numbers away from common CSI parameters.
+	zoneMarkerBase = 7000
+)
+
+// zoneStart returns the start marker for zone n.
+func zoneStart(n int) string {
+	return "\x1b[" + strconv.Itoa(zoneMarkerBase+2*n) + "z"
+}
+
+// zoneEnd returns the end marker for zone n.
+func zoneEnd(n int) string {
+	return "\x1b[" + strconv.Itoa(zoneMarkerBase+2*n+1) + "z"
+}
+
+// markZone wraps every line of content in the markers for zone n.
+func markZone(n int, content string) string {
+	start, end := zoneStart(n), zoneEnd(n)
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = start + line + end
+	}
+	return strings.Join(lines, "\n")
+}
+
+// scanZones removes zone markers from view and returns the cleaned view
+// along with the bounding rectangle of every zone found.
+func scanZones(view string) (string, map[int]Rect) {
+	type bounds struct {
+		minX, minY, maxX, maxY int
+	}
+	found := make(map[int]*bounds)
+	extend := func(n, x, y int) {
+		b, ok := found[n]
+		if !ok {
+			found[n] = &bounds{minX: x, minY: y, maxX: x, maxY: y}
+			return
+		}
+		b.minX = min(b.minX, x)
+		b.minY = min(b.minY, y)
+		b.maxX = max(b.maxX, x)
+		b.maxY = max(b.maxY, y)
+	}
+
+	// Start column of each zone on the current line; a zone only counts
+	// on lines where it covers at least one cell.
+	open := make(map[int]int)
+
+	var out strings.Builder
+	out.Grow(len(view))
+	x, y := 0, 0
+
+	for i := 0; i < len(view); {
+		c := view[i]
+
+		if c == '\n' {
+			out.WriteByte(c)
+			x = 0
+			y++
+			i++
+			continue
+		}
+
+		if c == '\x1b' {
+			seqLen := escapeLength(view[i:])
+			seq := view[i : i+seqLen]
+			if n, end, ok := parseZoneMarker(seq); ok {
+				if !end {
+					open[n] = x
+				} else if startX, ok := open[n]; ok {
+					delete(open, n)
+					// End markers sit after the last cell of the zone.
+					if x > startX {
+						extend(n, startX, y)
+						extend(n, x-1, y)
+					}
+				}
+			} else {
+				out.WriteString(seq)
+			}
+			i += seqLen
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(view[i:])
+		out.WriteString(view[i : i+size])
+		x += runewidth.RuneWidth(r)
+		i += size
+	}
+
+	zones := make(map[int]Rect, len(found))
+	for n, b := range found {
+		zones[n] = Rect{
+			X:      b.minX,
+			Y:      b.minY,
+			Width:  b.maxX - b.minX + 1,
+			Height: b.maxY - b.minY + 1,
+		}
+	}
+
+	return out.String(), zones
+}
+
+// escapeLength returns the byte length of the escape sequence at the start
+// of s. CSI and OSC sequences are recognized; anything else is treated as a
+// two-byte escape.
+func escapeLength(s string) int {
+	if len(s) < 2 {
+		return len(s)
+	}
+
+	switch s[1] {
+	case '[':
+		// CSI: parameters and intermediates, terminated by a final byte.
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']':
+		// OSC: terminated by BEL or ST (ESC \).
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	}
+
+	return 2
+}
+
+// parseZoneMarker reports whether seq is a zone marker and, if so, which
+// zone it belongs to and whether it is an end marker.
+func parseZoneMarker(seq string) (n int, end bool, ok bool) {
+	if len(seq) < 4 || seq[1] != '[' || seq[len(seq)-1] != 'z' {
+		return 0, false, false
+	}
+
+	v, err := strconv.Atoi(seq[2 : len(seq)-1])
+	if err != nil || v < zoneMarkerBase {
+		return 0, false, false
+	}
+
+	v -= zoneMarkerBase
+	return v / 2, v%2 == 1, true
+}
+
+// ZoneManager discovers where marked content ends up in a composed view.
+// Wrap components with Mark while building the view, then pass the final
+// view through Scan before returning it from your model's View method.
+type ZoneManager struct {
+	mu    sync.RWMutex
+	ids   map[string]int
+	zones map[string]Rect
+}
+
+// NewZoneManager creates a new zone manager.
+func NewZoneManager() *ZoneManager {
+	return &ZoneManager{
+		ids:   make(map[string]int),
+		zones: make(map[string]Rect),
+	}
+}
+
+// Mark wraps content in zero-width markers for the given zone ID.
+func (z *ZoneManager) Mark(id, content string) string {
+	z.mu.Lock()
+	n, ok := z.ids[id]
+	if !ok {
+		n = len(z.ids) + firstUserZone
+		z.ids[id] = n
+	}
+	z.mu.Unlock()
+
+	return markZone(n, content)
+}
+
+// Scan records the position of every marked zone in view and returns the
+// view with all markers removed. Zones missing from view are forgotten.
+func (z *ZoneManager) Scan(view string) string {
+	clean, found := scanZones(view)
+
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	z.zones = make(map[string]Rect, len(found))
+	for id, n := range z.ids {
+		if r, ok := found[n]; ok {
+			z.zones[id] = r
+		}
+	}
+
+	return clean
+}
+
+// Get returns the rectangle of a zone found by the last Scan.
+func (z *ZoneManager) Get(id string) (Rect, bool) {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+
+	r, ok := z.zones[id]
+	return r, ok
+}
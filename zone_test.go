@@ -0,0 +1,89 @@
+package tuslide
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestRect_Contains(t *testing.T) {
+	r := Rect{X: 2, Y: 1, Width: 3, Height: 2}
+
+	tests := []struct {
+		name     string
+		x, y     int
+		expected bool
+	}{
+		{"top-left", 2, 1, true},
+		{"bottom-right", 4, 2, true},
+		{"right edge", 5, 1, false},
+		{"below", 2, 3, false},
+		{"left", 1, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Contains(tt.x, tt.y); got != tt.expected {
+				t.Errorf("Contains(%d, %d) = %v, expected %v", tt.x, tt.y, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestZoneManager_MarkAndScan(t *testing.T) {
+	zm := NewZoneManager()
+
+	left := lipgloss.NewStyle().Padding(1, 2).Render("abc")
+	right := zm.Mark("box", "12345\n678")
+	view := "header\n" + lipgloss.JoinHorizontal(lipgloss.Top, left, " ", right)
+
+	clean := zm.Scan(view)
+	if strings.Contains(clean, "\x1b[") {
+		t.Error("Scan should remove zone markers")
+	}
+	if lipgloss.Width(clean) != lipgloss.Width(view) {
+		t.Errorf("Scan changed visible width: %d vs %d", lipgloss.Width(clean), lipgloss.Width(view))
+	}
+
+	r, ok := zm.Get("box")
+	if !ok {
+		t.Fatal("Zone should be found after Scan")
+	}
+
+	// "abc" with padding is 7 cells wide, followed by a 1-cell spacer.
+	expected := Rect{X: 8, Y: 1, Width: 5, Height: 2}
+	if r != expected {
+		t.Errorf("Expected %+v, got %+v", expected, r)
+	}
+}
+
+func TestZoneManager_ScanForgetsMissingZones(t *testing.T) {
+	zm := NewZoneManager()
+
+	zm.Scan(zm.Mark("a", "x"))
+	if _, ok := zm.Get("a"); !ok {
+		t.Fatal("Zone a should be found")
+	}
+
+	zm.Scan("plain view")
+	if _, ok := zm.Get("a"); ok {
+		t.Error("Zone a should be forgotten when missing from the view")
+	}
+}
+
+func TestZoneManager_PreservesOtherEscapes(t *testing.T) {
+	zm := NewZoneManager()
+
+	styled := "\x1b[1mbold\x1b[0m"
+	clean := zm.Scan(zm.Mark("s", styled))
+
+	if clean != styled {
+		t.Errorf("Expected styling to be preserved, got %q", clean)
+	}
+
+	r, _ := zm.Get("s")
+	if r.Width != 4 {
+		t.Errorf("Expected width 4 ignoring escapes, got %d", r.Width)
+	}
+}